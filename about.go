@@ -0,0 +1,40 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/jdu211171/clearlinux-cli/pkg/langplugin"
+)
+
+// cliVersion is the clearlinux-cli release version reported by `about`.
+const cliVersion = "0.1.0"
+
+var aboutCmd = &cobra.Command{
+	Use:   "about",
+	Short: "Show clearlinux-cli version and supported language information",
+	RunE:  runAbout,
+}
+
+type aboutInfo struct {
+	Version            string   `json:"version"`
+	SupportedLanguages []string `json:"supportedLanguages"`
+}
+
+func runAbout(cmd *cobra.Command, args []string) error {
+	plugins := langplugin.All()
+	langs := make([]string, len(plugins))
+	for i, p := range plugins {
+		langs[i] = p.Name()
+	}
+	sort.Strings(langs)
+
+	info := aboutInfo{Version: cliVersion, SupportedLanguages: langs}
+	return printResult(info, func() {
+		fmt.Printf("%s %s\n", headerStyle.Render("clearlinux-cli"), highlight.Render(cliVersion))
+		fmt.Printf("Supported languages: %s\n", highlight.Render(strings.Join(langs, ", ")))
+	})
+}
@@ -0,0 +1,34 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/spf13/cobra"
+
+	"github.com/jdu211171/clearlinux-cli/pkg/vermgr"
+)
+
+var envShell string
+
+var envCmd = &cobra.Command{
+	Use:   "env",
+	Short: "Print shell export statements that add installed toolchains to PATH",
+	RunE:  runEnv,
+}
+
+func init() {
+	envCmd.Flags().StringVar(&envShell, "shell", "bash", "target shell: bash, zsh, fish, or powershell")
+}
+
+func runEnv(cmd *cobra.Command, args []string) error {
+	langs := vermgr.Languages()
+	sort.Strings(langs)
+
+	script, err := vermgr.EnvScript(vermgr.Shell(envShell), langs)
+	if err != nil {
+		return err
+	}
+	fmt.Print(script)
+	return nil
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/jdu211171/clearlinux-cli/pkg/vermgr"
+)
+
+var installCmd = &cobra.Command{
+	Use:   "install <lang>@<version>",
+	Short: "Download and install a language toolchain version",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runInstall,
+}
+
+type installResult struct {
+	Language        string `json:"language"`
+	Version         string `json:"version"`
+	DownloadedBytes int64  `json:"downloadedBytes"`
+}
+
+func runInstall(cmd *cobra.Command, args []string) error {
+	lang, version, err := parseLangAtVersion(args[0])
+	if err != nil {
+		return err
+	}
+
+	manager, ok := vermgr.Get(lang)
+	if !ok {
+		return fmt.Errorf("unsupported language: %s", lang)
+	}
+
+	downloaded, err := installWithProgress(cmd.Context(), manager, version)
+	if err != nil {
+		return err
+	}
+
+	return printResult(installResult{Language: lang, Version: version, DownloadedBytes: downloaded}, func() {
+		fmt.Printf("Installed %s %s (%s downloaded)\n",
+			highlight.Render(lang), highlight.Render(version), formatBytes(downloaded))
+	})
+}
+
+// parseLangAtVersion splits a "<lang>@<version>" spec such as
+// "go@1.22.0" into its language and version parts.
+func parseLangAtVersion(spec string) (lang, version string, err error) {
+	lang, version, ok := strings.Cut(spec, "@")
+	if !ok {
+		return "", "", fmt.Errorf("expected <lang>@<version>, got %q", spec)
+	}
+	return strings.ToLower(lang), version, nil
+}
@@ -0,0 +1,91 @@
+// Package editorprov installs the editors offered in the setup wizard
+// when they aren't already present, and drops language-appropriate
+// configuration (extensions, LSP snippets) so the toolchain just set up
+// is ready to use in them.
+package editorprov
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/jdu211171/clearlinux-cli/pkg/vermgr"
+)
+
+// Provisioner is implemented by each supported editor.
+type Provisioner interface {
+	// Name is the editor's display name, matching the value used in the
+	// setup wizard's editor huh.MultiSelect, e.g. "VS Code".
+	Name() string
+	// Installed reports whether the editor is already present.
+	Installed() bool
+	// Install downloads and sets up the editor.
+	Install(ctx context.Context) error
+	// Configure drops language-appropriate config for an already
+	// installed editor, e.g. extensions or an LSP snippet.
+	Configure(ctx context.Context, lang, toolchainPath string) error
+}
+
+var registry = map[string]Provisioner{}
+
+// Register makes p available under its Name(). Built-in provisioners
+// call this from an init() in their own file.
+func Register(p Provisioner) {
+	registry[p.Name()] = p
+}
+
+// Get returns the Provisioner registered for name, or false if none is.
+func Get(name string) (Provisioner, bool) {
+	p, ok := registry[name]
+	return p, ok
+}
+
+// Result is the outcome of provisioning one editor, shown in the setup
+// summary.
+type Result struct {
+	Editor     string `json:"editor"`
+	Installed  bool   `json:"installed"`
+	Configured bool   `json:"configured"`
+	Error      string `json:"error,omitempty"`
+}
+
+// Provision installs editor (if missing) and configures it for lang,
+// whose toolchain now lives at toolchainPath. It never returns an
+// error itself; failures are reported on the returned Result so one bad
+// editor doesn't abort the others.
+func Provision(ctx context.Context, editor, lang, toolchainPath string) Result {
+	result := Result{Editor: editor}
+
+	p, ok := registry[editor]
+	if !ok {
+		result.Error = fmt.Sprintf("no provisioner registered for %s", editor)
+		return result
+	}
+
+	if !p.Installed() {
+		if err := p.Install(ctx); err != nil {
+			result.Error = fmt.Sprintf("install: %s", err)
+			return result
+		}
+	}
+	result.Installed = true
+
+	if err := p.Configure(ctx, lang, toolchainPath); err != nil {
+		result.Error = fmt.Sprintf("configure: %s", err)
+		return result
+	}
+	result.Configured = true
+	return result
+}
+
+// dataDir returns the directory editorprov installs editors under,
+// namespaced alongside vermgr's language toolchains.
+func dataDir(name string) (string, error) {
+	root, err := vermgr.DataDir()
+	if err != nil {
+		return "", err
+	}
+	slug := strings.ToLower(strings.ReplaceAll(name, " ", "-"))
+	return filepath.Join(root, "editors", slug), nil
+}
@@ -0,0 +1,99 @@
+package editorprov
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+)
+
+func init() {
+	Register(neovimProvisioner{})
+}
+
+// neovimLSPServers maps a detected language to the LSP server Neovim's
+// init.lua snippet should wire up via lspconfig.
+var neovimLSPServers = map[string]string{
+	"go":         "gopls",
+	"python":     "pyright",
+	"javascript": "ts_ls",
+	"rust":       "rust_analyzer",
+	"java":       "jdtls",
+}
+
+// neovimProvisioner installs Neovim from its GitHub release tarball and
+// configures it by dropping an init.lua lspconfig snippet that points
+// at the language's toolchain.
+type neovimProvisioner struct{}
+
+func (neovimProvisioner) Name() string { return "Neovim" }
+
+func (neovimProvisioner) Installed() bool {
+	_, err := exec.LookPath("nvim")
+	return err == nil
+}
+
+func (neovimProvisioner) Install(ctx context.Context) error {
+	dir, err := dataDir("neovim")
+	if err != nil {
+		return err
+	}
+
+	arch := "x86_64"
+	if runtime.GOARCH == "arm64" {
+		arch = "arm64"
+	}
+	url := fmt.Sprintf("https://github.com/neovim/neovim/releases/latest/download/nvim-linux-%s.tar.gz", arch)
+	if err := downloadExtractTarGz(ctx, url, dir); err != nil {
+		return err
+	}
+
+	bin := filepath.Join(dir, fmt.Sprintf("nvim-linux-%s", arch), "bin", "nvim")
+	binDir := filepath.Join(filepath.Dir(dir), "bin")
+	if err := os.MkdirAll(binDir, 0o755); err != nil {
+		return fmt.Errorf("create %s: %w", binDir, err)
+	}
+	target := filepath.Join(binDir, "nvim")
+	_ = os.Remove(target)
+	if err := os.Symlink(bin, target); err != nil {
+		return fmt.Errorf("link nvim: %w", err)
+	}
+	return nil
+}
+
+func (neovimProvisioner) Configure(ctx context.Context, lang, toolchainPath string) error {
+	server, ok := neovimLSPServers[lang]
+	if !ok {
+		return nil
+	}
+
+	configDir := os.Getenv("XDG_CONFIG_HOME")
+	if configDir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return fmt.Errorf("resolve home directory: %w", err)
+		}
+		configDir = filepath.Join(home, ".config")
+	}
+	// Neovim auto-sources every *.lua file directly under a plugin/
+	// subdirectory of its config dir at startup, so that's where this
+	// snippet needs to live for it to actually take effect; a file
+	// dropped straight in configDir would never be read.
+	pluginDir := filepath.Join(configDir, "nvim", "plugin")
+	if err := os.MkdirAll(pluginDir, 0o755); err != nil {
+		return fmt.Errorf("create %s: %w", pluginDir, err)
+	}
+
+	snippet := fmt.Sprintf(`-- Added by clearlinux-cli for %s.
+vim.env.PATH = %q .. ":" .. vim.env.PATH
+require('lspconfig').%s.setup({})
+`, lang, filepath.Join(toolchainPath, "bin"), server)
+
+	path := filepath.Join(pluginDir, fmt.Sprintf("clearlinux-cli-%s.lua", lang))
+	if err := os.WriteFile(path, []byte(snippet), 0o644); err != nil {
+		return fmt.Errorf("write %s: %w", path, err)
+	}
+	return nil
+}
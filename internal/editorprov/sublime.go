@@ -0,0 +1,117 @@
+package editorprov
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+)
+
+func init() {
+	Register(sublimeProvisioner{})
+}
+
+// sublimeBuildCmd maps a detected language to the command its build
+// system runs the current file with.
+var sublimeBuildCmd = map[string][]string{
+	"go":         {"go", "run", "$file"},
+	"python":     {"python3", "$file"},
+	"javascript": {"node", "$file"},
+	"rust":       {"rustc", "$file", "--out-dir", "$file_path"},
+	"java":       {"java", "$file"},
+}
+
+// sublimeProvisioner installs Sublime Text from Clear Linux's swupd
+// bundle where available, otherwise falls back to the direct Linux
+// tarball Sublime publishes (Clear Linux has no apt-style repo to add,
+// unlike Debian-based distros). Configuration drops a .sublime-build
+// build system under the user's Packages/User directory that runs the
+// current file with the installed toolchain's bin/ on PATH.
+type sublimeProvisioner struct{}
+
+func (sublimeProvisioner) Name() string { return "Sublime Text" }
+
+func (sublimeProvisioner) Installed() bool {
+	_, err := exec.LookPath("subl")
+	return err == nil
+}
+
+func (sublimeProvisioner) Install(ctx context.Context) error {
+	if _, err := exec.LookPath("swupd"); err == nil {
+		cmd := exec.CommandContext(ctx, "swupd", "bundle-add", "sublime-text")
+		if _, err := cmd.CombinedOutput(); err == nil {
+			return nil
+		}
+		// swupd may not carry this bundle on this release; fall through
+		// to the direct tarball.
+	}
+
+	dir, err := dataDir("sublime-text")
+	if err != nil {
+		return err
+	}
+
+	arch := "x64"
+	if runtime.GOARCH == "arm64" {
+		arch = "arm64"
+	}
+	url := fmt.Sprintf("https://download.sublimetext.com/sublime_text_build_4169_%s.tar.gz", arch)
+	if err := downloadExtractTarGz(ctx, url, dir); err != nil {
+		return fmt.Errorf("download Sublime Text tarball: %w", err)
+	}
+
+	binDir := filepath.Join(filepath.Dir(dir), "bin")
+	if err := os.MkdirAll(binDir, 0o755); err != nil {
+		return fmt.Errorf("create %s: %w", binDir, err)
+	}
+	target := filepath.Join(binDir, "subl")
+	_ = os.Remove(target)
+	return os.Symlink(filepath.Join(dir, "sublime_text"), target)
+}
+
+func (sublimeProvisioner) Configure(ctx context.Context, lang, toolchainPath string) error {
+	if _, ok := sublimeBuildCmd[lang]; !ok {
+		return nil
+	}
+
+	configDir := os.Getenv("XDG_CONFIG_HOME")
+	if configDir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return fmt.Errorf("resolve home directory: %w", err)
+		}
+		configDir = filepath.Join(home, ".config")
+	}
+	configDir = filepath.Join(configDir, "sublime-text", "Packages", "User")
+	if err := os.MkdirAll(configDir, 0o755); err != nil {
+		return fmt.Errorf("create %s: %w", configDir, err)
+	}
+
+	// Sublime only merges settings from recognized filenames (e.g.
+	// Preferences.sublime-settings, <Syntax>.sublime-settings) and has
+	// no "env" key outside build systems, so a PATH override belongs in
+	// a .sublime-build file instead - those are auto-discovered under
+	// Packages/User regardless of filename and do support "env".
+	build := struct {
+		Name string            `json:"name"`
+		Cmd  []string          `json:"cmd"`
+		Env  map[string]string `json:"env"`
+	}{
+		Name: fmt.Sprintf("clearlinux-cli: %s", lang),
+		Cmd:  sublimeBuildCmd[lang],
+		Env:  map[string]string{"PATH": filepath.Join(toolchainPath, "bin") + string(os.PathListSeparator) + os.Getenv("PATH")},
+	}
+	content, err := json.MarshalIndent(build, "", "\t")
+	if err != nil {
+		return fmt.Errorf("encode sublime build system: %w", err)
+	}
+
+	path := filepath.Join(configDir, fmt.Sprintf("clearlinux-cli-%s.sublime-build", lang))
+	if err := os.WriteFile(path, content, 0o644); err != nil {
+		return fmt.Errorf("write %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package editorprov
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+)
+
+func init() {
+	Register(vscodeProvisioner{})
+}
+
+// vscodeExtensions maps a detected language to the VS Code marketplace
+// extension ID that should be installed for it.
+var vscodeExtensions = map[string]string{
+	"go":         "golang.go",
+	"python":     "ms-python.python",
+	"javascript": "dbaeumer.vscode-eslint",
+	"rust":       "rust-lang.rust-analyzer",
+	"java":       "redhat.java",
+}
+
+// vscodeProvisioner installs VS Code via swupd on Clear Linux, falling
+// back to Flatpak where swupd isn't available, and configures it by
+// installing the language's extension with `code --install-extension`.
+type vscodeProvisioner struct{}
+
+func (vscodeProvisioner) Name() string { return "VS Code" }
+
+func (vscodeProvisioner) Installed() bool {
+	_, err := exec.LookPath("code")
+	return err == nil
+}
+
+func (vscodeProvisioner) Install(ctx context.Context) error {
+	if _, err := exec.LookPath("swupd"); err == nil {
+		cmd := exec.CommandContext(ctx, "swupd", "bundle-add", "vscode")
+		if out, err := cmd.CombinedOutput(); err != nil {
+			return fmt.Errorf("swupd bundle-add vscode: %w: %s", err, out)
+		}
+		return nil
+	}
+
+	if _, err := exec.LookPath("flatpak"); err == nil {
+		cmd := exec.CommandContext(ctx, "flatpak", "install", "-y", "flathub", "com.visualstudio.code")
+		if out, err := cmd.CombinedOutput(); err != nil {
+			return fmt.Errorf("flatpak install com.visualstudio.code: %w: %s", err, out)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("neither swupd nor flatpak is available to install VS Code")
+}
+
+func (vscodeProvisioner) Configure(ctx context.Context, lang, toolchainPath string) error {
+	ext, ok := vscodeExtensions[lang]
+	if !ok {
+		return nil
+	}
+	cmd := exec.CommandContext(ctx, "code", "--install-extension", ext)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("code --install-extension %s: %w: %s", ext, err, out)
+	}
+	return nil
+}
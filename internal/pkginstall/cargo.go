@@ -0,0 +1,68 @@
+package pkginstall
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/BurntSushi/toml"
+)
+
+func init() {
+	RegisterRunner("rust", cargoRunner{})
+}
+
+// cargoRunner resolves cargo's dependency tree by running `cargo add`
+// against a throwaway scratch crate and reading back the Cargo.lock it
+// generates there, which pins the exact version selected for every
+// crate in the graph, without touching the caller's own Cargo.toml.
+type cargoRunner struct{}
+
+func (cargoRunner) Ecosystem() Ecosystem { return CratesIO }
+
+type cargoLock struct {
+	Package []struct {
+		Name    string `toml:"name"`
+		Version string `toml:"version"`
+	} `toml:"package"`
+}
+
+func (cargoRunner) Install(ctx context.Context, pkg, toolchainBin string) ([]ResolvedDependency, error) {
+	dir, err := os.MkdirTemp("", "clearlinux-cli-cargo-resolve-*")
+	if err != nil {
+		return nil, fmt.Errorf("create resolve dir: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	env := toolchainEnv(toolchainBin)
+	var stderr bytes.Buffer
+	initCmd := exec.CommandContext(ctx, "cargo", "init", "--name", "resolve", "--vcs", "none")
+	initCmd.Dir = dir
+	initCmd.Env = env
+	initCmd.Stderr = &stderr
+	if err := initCmd.Run(); err != nil {
+		return nil, fmt.Errorf("cargo init: %w: %s", err, stderr.String())
+	}
+
+	addCmd := exec.CommandContext(ctx, "cargo", "add", pkg)
+	addCmd.Dir = dir
+	addCmd.Env = env
+	addCmd.Stderr = &stderr
+	if err := addCmd.Run(); err != nil {
+		return nil, fmt.Errorf("cargo add %s: %w: %s", pkg, err, stderr.String())
+	}
+
+	var lock cargoLock
+	if _, err := toml.DecodeFile(filepath.Join(dir, "Cargo.lock"), &lock); err != nil {
+		return nil, fmt.Errorf("decode Cargo.lock: %w", err)
+	}
+
+	deps := make([]ResolvedDependency, len(lock.Package))
+	for i, p := range lock.Package {
+		deps[i] = ResolvedDependency{Name: p.Name, Version: p.Version}
+	}
+	return deps, nil
+}
@@ -0,0 +1,68 @@
+package pkginstall
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+func init() {
+	RegisterRunner("go", goRunner{})
+}
+
+// goRunner resolves pkg's dependency tree in a throwaway module via `go
+// get` followed by `go list -m all`, which reports the final,
+// MVS-resolved version of every module in the build list, without
+// installing anything into the caller's own environment.
+type goRunner struct{}
+
+func (goRunner) Ecosystem() Ecosystem { return GoModules }
+
+func (goRunner) Install(ctx context.Context, pkg, toolchainBin string) ([]ResolvedDependency, error) {
+	dir, err := os.MkdirTemp("", "clearlinux-cli-go-resolve-*")
+	if err != nil {
+		return nil, fmt.Errorf("create resolve dir: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	env := toolchainEnv(toolchainBin)
+	var stderr bytes.Buffer
+	initCmd := exec.CommandContext(ctx, "go", "mod", "init", "resolve")
+	initCmd.Dir = dir
+	initCmd.Env = env
+	initCmd.Stderr = &stderr
+	if err := initCmd.Run(); err != nil {
+		return nil, fmt.Errorf("go mod init: %w: %s", err, stderr.String())
+	}
+
+	getCmd := exec.CommandContext(ctx, "go", "get", pkg+"@latest")
+	getCmd.Dir = dir
+	getCmd.Env = env
+	getCmd.Stderr = &stderr
+	if err := getCmd.Run(); err != nil {
+		return nil, fmt.Errorf("go get %s: %w: %s", pkg, err, stderr.String())
+	}
+
+	listCmd := exec.CommandContext(ctx, "go", "list", "-m", "all")
+	listCmd.Dir = dir
+	listCmd.Env = env
+	var out bytes.Buffer
+	listCmd.Stdout = &out
+	listCmd.Stderr = &stderr
+	if err := listCmd.Run(); err != nil {
+		return nil, fmt.Errorf("go list -m all: %w: %s", err, stderr.String())
+	}
+
+	var deps []ResolvedDependency
+	for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			continue // the main module's own line has no version
+		}
+		deps = append(deps, ResolvedDependency{Name: fields[0], Version: fields[1]})
+	}
+	return deps, nil
+}
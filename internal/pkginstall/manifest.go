@@ -0,0 +1,106 @@
+package pkginstall
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// ManifestEntry records one package accepted in a setup run, with its
+// resolved dependencies, so later runs can offer to re-check it.
+type ManifestEntry struct {
+	Language     string               `json:"language"`
+	Package      string               `json:"package"`
+	Dependencies []ResolvedDependency `json:"dependencies"`
+	InstalledAt  time.Time            `json:"installedAt"`
+}
+
+// Manifest is the full record of packages accepted in setup runs,
+// persisted at ~/.config/clearlinux-cli/manifest.json.
+type Manifest struct {
+	Entries []ManifestEntry `json:"entries"`
+}
+
+// ManifestPath returns where the manifest is stored, honoring
+// XDG_CONFIG_HOME.
+func ManifestPath() (string, error) {
+	base := os.Getenv("XDG_CONFIG_HOME")
+	if base == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("resolve home directory: %w", err)
+		}
+		base = filepath.Join(home, ".config")
+	}
+	return filepath.Join(base, "clearlinux-cli", "manifest.json"), nil
+}
+
+// LoadManifest reads the manifest, returning an empty one if it does not
+// exist yet.
+func LoadManifest() (*Manifest, error) {
+	path, err := ManifestPath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return &Manifest{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read manifest: %w", err)
+	}
+
+	var manifest Manifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("decode manifest: %w", err)
+	}
+	return &manifest, nil
+}
+
+// ForLanguage returns the entries previously installed for lang, so a
+// later run can offer to reinstall or upgrade them.
+func (m *Manifest) ForLanguage(lang string) []ManifestEntry {
+	var entries []ManifestEntry
+	for _, entry := range m.Entries {
+		if entry.Language == lang {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+// Upsert records entry, replacing any existing entry for the same
+// language and package.
+func (m *Manifest) Upsert(entry ManifestEntry) {
+	for i, existing := range m.Entries {
+		if existing.Language == entry.Language && existing.Package == entry.Package {
+			m.Entries[i] = entry
+			return
+		}
+	}
+	m.Entries = append(m.Entries, entry)
+}
+
+// Save writes the manifest back to ManifestPath, creating its parent
+// directory if needed.
+func (m *Manifest) Save() error {
+	path, err := ManifestPath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("create manifest dir: %w", err)
+	}
+
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode manifest: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("write manifest: %w", err)
+	}
+	return nil
+}
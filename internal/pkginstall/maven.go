@@ -0,0 +1,42 @@
+package pkginstall
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"regexp"
+)
+
+func init() {
+	RegisterRunner("java", mavenRunner{})
+}
+
+// mavenRunner resolves Maven's dependency tree via `mvn dependency:tree
+// -Dincludes=<pkg>`, which prints the resolved group:artifact:type:version
+// for the package and everything it pulls in.
+type mavenRunner struct{}
+
+func (mavenRunner) Ecosystem() Ecosystem { return Maven }
+
+var mavenTreeLineRe = regexp.MustCompile(`([\w.-]+):([\w.-]+):[\w.-]+:([\w.-]+)(?::[\w.-]+)?`)
+
+func (mavenRunner) Install(ctx context.Context, pkg, toolchainBin string) ([]ResolvedDependency, error) {
+	cmd := exec.CommandContext(ctx, "mvn", "-q", "dependency:tree", "-Dincludes="+pkg)
+	cmd.Env = toolchainEnv(toolchainBin)
+	var out, stderr bytes.Buffer
+	cmd.Stdout = &out
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("mvn dependency:tree -Dincludes=%s: %w: %s", pkg, err, stderr.String())
+	}
+
+	var deps []ResolvedDependency
+	for _, m := range mavenTreeLineRe.FindAllStringSubmatch(out.String(), -1) {
+		deps = append(deps, ResolvedDependency{
+			Name:    m[1] + ":" + m[2],
+			Version: m[3],
+		})
+	}
+	return deps, nil
+}
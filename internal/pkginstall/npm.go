@@ -0,0 +1,58 @@
+package pkginstall
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+)
+
+func init() {
+	RegisterRunner("javascript", npmRunner{})
+}
+
+// npmRunner resolves npm's dependency tree via `npm install
+// --package-lock-only --json` run against a throwaway scratch
+// directory, whose "dependencies" map reports the version npm actually
+// resolved for the package and everything it pulled in, without
+// touching the caller's own package-lock.json.
+type npmRunner struct{}
+
+func (npmRunner) Ecosystem() Ecosystem { return NPM }
+
+type npmInstallReport struct {
+	Dependencies map[string]struct {
+		Version string `json:"version"`
+	} `json:"dependencies"`
+}
+
+func (npmRunner) Install(ctx context.Context, pkg, toolchainBin string) ([]ResolvedDependency, error) {
+	dir, err := os.MkdirTemp("", "clearlinux-cli-npm-resolve-*")
+	if err != nil {
+		return nil, fmt.Errorf("create resolve dir: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	cmd := exec.CommandContext(ctx, "npm", "install", "--package-lock-only", "--json", pkg)
+	cmd.Dir = dir
+	cmd.Env = toolchainEnv(toolchainBin)
+	var out, stderr bytes.Buffer
+	cmd.Stdout = &out
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("npm install --package-lock-only %s: %w: %s", pkg, err, stderr.String())
+	}
+
+	var report npmInstallReport
+	if err := json.Unmarshal(out.Bytes(), &report); err != nil {
+		return nil, fmt.Errorf("decode npm install report: %w", err)
+	}
+
+	deps := make([]ResolvedDependency, 0, len(report.Dependencies))
+	for name, info := range report.Dependencies {
+		deps = append(deps, ResolvedDependency{Name: name, Version: info.Version})
+	}
+	return deps, nil
+}
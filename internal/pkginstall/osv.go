@@ -0,0 +1,129 @@
+package pkginstall
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Vulnerability is the subset of an OSV record shown to the user before
+// they confirm an install.
+type Vulnerability struct {
+	ID       string `json:"id"`
+	Summary  string `json:"summary"`
+	Severity string `json:"severity,omitempty"`
+}
+
+type osvBatchQuery struct {
+	Queries []osvQuery `json:"queries"`
+}
+
+type osvQuery struct {
+	Version string     `json:"version"`
+	Package osvPackage `json:"package"`
+}
+
+type osvPackage struct {
+	Name      string `json:"name"`
+	Ecosystem string `json:"ecosystem"`
+}
+
+type osvBatchResponse struct {
+	Results []struct {
+		Vulns []struct {
+			ID string `json:"id"`
+		} `json:"vulns"`
+	} `json:"results"`
+}
+
+type osvVuln struct {
+	ID       string `json:"id"`
+	Summary  string `json:"summary"`
+	Severity []struct {
+		Type  string `json:"type"`
+		Score string `json:"score"`
+	} `json:"severity"`
+}
+
+// CheckVulnerabilities looks up every dependency against the OSV
+// database (https://api.osv.dev) and returns any known vulnerabilities,
+// keyed by "name@version".
+func CheckVulnerabilities(ctx context.Context, ecosystem Ecosystem, deps []ResolvedDependency) (map[string][]Vulnerability, error) {
+	if len(deps) == 0 {
+		return nil, nil
+	}
+
+	batch := osvBatchQuery{Queries: make([]osvQuery, len(deps))}
+	for i, d := range deps {
+		batch.Queries[i] = osvQuery{
+			Version: d.Version,
+			Package: osvPackage{Name: d.Name, Ecosystem: string(ecosystem)},
+		}
+	}
+
+	body, err := json.Marshal(batch)
+	if err != nil {
+		return nil, fmt.Errorf("encode osv batch query: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://api.osv.dev/v1/querybatch", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("query osv: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var batchResp osvBatchResponse
+	if err := json.NewDecoder(resp.Body).Decode(&batchResp); err != nil {
+		return nil, fmt.Errorf("decode osv batch response: %w", err)
+	}
+
+	found := map[string][]Vulnerability{}
+	for i, result := range batchResp.Results {
+		if len(result.Vulns) == 0 {
+			continue
+		}
+		dep := deps[i]
+		key := fmt.Sprintf("%s@%s", dep.Name, dep.Version)
+		for _, v := range result.Vulns {
+			vuln, err := fetchVulnDetails(ctx, v.ID)
+			if err != nil {
+				continue
+			}
+			found[key] = append(found[key], vuln)
+		}
+	}
+	return found, nil
+}
+
+// fetchVulnDetails resolves an OSV ID to its summary and severity, since
+// querybatch only returns IDs.
+func fetchVulnDetails(ctx context.Context, id string) (Vulnerability, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://api.osv.dev/v1/vulns/"+id, nil)
+	if err != nil {
+		return Vulnerability{}, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return Vulnerability{}, fmt.Errorf("fetch osv vuln %s: %w", id, err)
+	}
+	defer resp.Body.Close()
+
+	var v osvVuln
+	if err := json.NewDecoder(resp.Body).Decode(&v); err != nil {
+		return Vulnerability{}, fmt.Errorf("decode osv vuln %s: %w", id, err)
+	}
+
+	severity := ""
+	if len(v.Severity) > 0 {
+		severity = v.Severity[0].Score
+	}
+	return Vulnerability{ID: v.ID, Summary: v.Summary, Severity: severity}, nil
+}
@@ -0,0 +1,51 @@
+package pkginstall
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+)
+
+func init() {
+	RegisterRunner("python", pipRunner{})
+}
+
+// pipRunner resolves pip's dependency tree via `pip install --dry-run
+// --report -`, which prints the full install plan as JSON without
+// touching the environment.
+type pipRunner struct{}
+
+func (pipRunner) Ecosystem() Ecosystem { return PyPI }
+
+type pipReport struct {
+	Install []struct {
+		Metadata struct {
+			Name    string `json:"name"`
+			Version string `json:"version"`
+		} `json:"metadata"`
+	} `json:"install"`
+}
+
+func (pipRunner) Install(ctx context.Context, pkg, toolchainBin string) ([]ResolvedDependency, error) {
+	cmd := exec.CommandContext(ctx, "pip", "install", "--dry-run", "--quiet", "--report", "-", pkg)
+	cmd.Env = toolchainEnv(toolchainBin)
+	var out, stderr bytes.Buffer
+	cmd.Stdout = &out
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("pip install --dry-run %s: %w: %s", pkg, err, stderr.String())
+	}
+
+	var report pipReport
+	if err := json.Unmarshal(out.Bytes(), &report); err != nil {
+		return nil, fmt.Errorf("decode pip install report: %w", err)
+	}
+
+	deps := make([]ResolvedDependency, len(report.Install))
+	for i, entry := range report.Install {
+		deps[i] = ResolvedDependency{Name: entry.Metadata.Name, Version: entry.Metadata.Version}
+	}
+	return deps, nil
+}
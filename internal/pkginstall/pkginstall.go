@@ -0,0 +1,111 @@
+// Package pkginstall drives each language's package manager to resolve
+// the dependency trees of a starter set of packages and cross-references
+// the result against the OSV vulnerability database. Resolution runs as a
+// dry run, in a throwaway scratch directory, or as a dependency:tree
+// report; no runner installs the packages into the user's environment.
+package pkginstall
+
+import (
+	"context"
+	"fmt"
+	"os"
+)
+
+// Ecosystem identifies a package registry in OSV's vocabulary
+// (https://ossf.github.io/osv-schema/#affectedpackage-field).
+type Ecosystem string
+
+const (
+	PyPI      Ecosystem = "PyPI"
+	NPM       Ecosystem = "npm"
+	CratesIO  Ecosystem = "crates.io"
+	GoModules Ecosystem = "Go"
+	Maven     Ecosystem = "Maven"
+)
+
+// ResolvedDependency is one entry in a package's resolved dependency
+// tree, including the package itself.
+type ResolvedDependency struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// Runner drives a single language's package manager.
+type Runner interface {
+	// Ecosystem identifies which package registry this runner resolves
+	// against, for OSV lookups.
+	Ecosystem() Ecosystem
+	// Install invokes the package manager for pkg and returns its fully
+	// resolved dependency tree, pkg included. toolchainBin, if non-empty,
+	// is the bin directory of the vermgr-installed toolchain and is
+	// prepended to the subprocess's PATH so the package manager resolved
+	// is the one just installed rather than whatever is ambient on the
+	// machine.
+	Install(ctx context.Context, pkg, toolchainBin string) ([]ResolvedDependency, error)
+}
+
+var runners = map[string]Runner{}
+
+// RegisterRunner makes a Runner available under lang (e.g. "go"). Built-in
+// runners call this from an init() in their own file.
+func RegisterRunner(lang string, r Runner) {
+	runners[lang] = r
+}
+
+// GetRunner returns the Runner registered for lang, or false if none is.
+func GetRunner(lang string) (Runner, bool) {
+	r, ok := runners[lang]
+	return r, ok
+}
+
+// toolchainEnv returns the environment a Runner's subprocess should run
+// with: the current process's environment, but with toolchainBin
+// prepended to PATH so the package manager resolved on PATH is the one
+// vermgr just installed rather than whatever is ambient on the machine.
+// If toolchainBin is empty, the environment is returned unmodified.
+func toolchainEnv(toolchainBin string) []string {
+	env := os.Environ()
+	if toolchainBin == "" {
+		return env
+	}
+	return append(env, "PATH="+toolchainBin+string(os.PathListSeparator)+os.Getenv("PATH"))
+}
+
+// InstallResult is the full outcome of installing one package: its
+// resolved dependencies and any vulnerabilities found in them.
+type InstallResult struct {
+	Package         string                     `json:"package"`
+	Ecosystem       Ecosystem                  `json:"ecosystem"`
+	Dependencies    []ResolvedDependency        `json:"dependencies"`
+	Vulnerabilities map[string][]Vulnerability `json:"vulnerabilities,omitempty"`
+}
+
+// Install resolves pkg's dependency tree via lang's registered Runner
+// and checks every resolved version for known vulnerabilities.
+// toolchainBin, if non-empty, is the bin directory of the
+// vermgr-installed toolchain for lang and is threaded through to the
+// Runner so it execs the just-installed package manager rather than
+// whatever happens to be ambient on PATH.
+func Install(ctx context.Context, lang, pkg, toolchainBin string) (InstallResult, error) {
+	runner, ok := GetRunner(lang)
+	if !ok {
+		return InstallResult{}, fmt.Errorf("no package runner registered for %s", lang)
+	}
+
+	deps, err := runner.Install(ctx, pkg, toolchainBin)
+	if err != nil {
+		return InstallResult{}, fmt.Errorf("install %s: %w", pkg, err)
+	}
+
+	vulns, err := CheckVulnerabilities(ctx, runner.Ecosystem(), deps)
+	if err != nil {
+		return InstallResult{}, fmt.Errorf("check vulnerabilities for %s: %w", pkg, err)
+	}
+
+	return InstallResult{
+		Package:         pkg,
+		Ecosystem:       runner.Ecosystem(),
+		Dependencies:    deps,
+		Vulnerabilities: vulns,
+	}, nil
+}
@@ -0,0 +1,27 @@
+package pkginstall
+
+import "github.com/charmbracelet/huh"
+
+// starterPackages offers a small, opinionated starter set per language
+// for the post-setup package install step.
+var starterPackages = map[string][]string{
+	"python":     {"requests", "pytest", "black"},
+	"javascript": {"express", "jest", "eslint"},
+	"go":         {"github.com/spf13/cobra", "github.com/stretchr/testify"},
+	"rust":       {"serde", "tokio", "clap"},
+	"java":       {"org.junit.jupiter:junit-jupiter", "com.google.guava:guava"},
+}
+
+// StarterPackageOptions renders lang's starter set as huh.MultiSelect
+// options, or nil if the language has none registered.
+func StarterPackageOptions(lang string) []huh.Option[string] {
+	packages, ok := starterPackages[lang]
+	if !ok {
+		return nil
+	}
+	options := make([]huh.Option[string], len(packages))
+	for i, pkg := range packages {
+		options[i] = huh.NewOption(pkg, pkg)
+	}
+	return options
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/jdu211171/clearlinux-cli/pkg/vermgr"
+)
+
+var listCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List installed toolchain versions for every language",
+	RunE:  runList,
+}
+
+// installedLanguage groups a language's installed versions for list's
+// --json output.
+type installedLanguage struct {
+	Language string                `json:"language"`
+	Versions []vermgr.LocalVersion `json:"versions"`
+}
+
+func runList(cmd *cobra.Command, args []string) error {
+	langs := vermgr.Languages()
+	sort.Strings(langs)
+
+	result := make([]installedLanguage, 0, len(langs))
+	for _, lang := range langs {
+		manager, _ := vermgr.Get(lang)
+		versions, err := manager.Installed()
+		if err != nil {
+			return fmt.Errorf("list installed %s versions: %w", lang, err)
+		}
+		result = append(result, installedLanguage{Language: lang, Versions: versions})
+	}
+
+	return printResult(result, func() {
+		for _, entry := range result {
+			if len(entry.Versions) == 0 {
+				continue
+			}
+			fmt.Println(headerStyle.Render(entry.Language))
+			for _, v := range entry.Versions {
+				marker := " "
+				if v.Active {
+					marker = "*"
+				}
+				fmt.Printf(" %s %s\n", marker, highlight.Render(v.Version))
+			}
+		}
+	})
+}
+
+var listRemoteCmd = &cobra.Command{
+	Use:   "list-remote <lang>",
+	Short: "List versions available to install for a language",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runListRemote,
+}
+
+func runListRemote(cmd *cobra.Command, args []string) error {
+	lang := strings.ToLower(args[0])
+
+	manager, ok := vermgr.Get(lang)
+	if !ok {
+		return fmt.Errorf("unsupported language: %s", lang)
+	}
+
+	remote, err := manager.List(cmd.Context())
+	if err != nil {
+		return fmt.Errorf("list %s versions: %w", lang, err)
+	}
+
+	return printResult(remote, func() {
+		for _, v := range remote {
+			label := v.Version
+			switch {
+			case v.Latest:
+				label += " (Latest)"
+			case v.LTS:
+				label += " (LTS)"
+			}
+			fmt.Println(highlight.Render(label))
+		}
+	})
+}
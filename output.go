@@ -0,0 +1,37 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// printResult renders result as the global --json flag dictates:
+// indented JSON to stdout when set, or by calling human for the usual
+// styled output.
+func printResult(result any, human func()) error {
+	if jsonOutput {
+		data, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return fmt.Errorf("marshal result: %w", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+	human()
+	return nil
+}
+
+// formatBytes renders a byte count the way progress/summary output
+// expects, e.g. "42.3 MB".
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for v := n / unit; v >= unit; v /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", float64(n)/float64(div), "KMGTPE"[exp])
+}
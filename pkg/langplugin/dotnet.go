@@ -0,0 +1,31 @@
+package langplugin
+
+import (
+	"context"
+	"strings"
+
+	"github.com/charmbracelet/huh"
+)
+
+func init() {
+	RegisterLanguage(dotnetPlugin{})
+}
+
+type dotnetPlugin struct{}
+
+func (dotnetPlugin) Name() string { return ".NET" }
+func (dotnetPlugin) Icon() string { return "🟣" }
+
+func (dotnetPlugin) DetectInstalled() (version, path string, err error) {
+	return detectByCommand("dotnet", "--version", func(out string) string {
+		return strings.TrimSpace(out)
+	})
+}
+
+func (dotnetPlugin) AvailableVersions(ctx context.Context) ([]string, error) {
+	return formattedVersions(ctx, ".net")
+}
+
+func (dotnetPlugin) RecommendedEditors() []huh.Option[string] {
+	return append(baseEditors(), huh.NewOption("Rider 🟣", "Rider"))
+}
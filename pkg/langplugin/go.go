@@ -0,0 +1,83 @@
+package langplugin
+
+import (
+	"context"
+	"os/exec"
+	"strings"
+
+	"github.com/charmbracelet/huh"
+
+	"github.com/jdu211171/clearlinux-cli/pkg/vermgr"
+)
+
+func init() {
+	RegisterLanguage(goPlugin{})
+}
+
+type goPlugin struct{}
+
+func (goPlugin) Name() string { return "Go" }
+func (goPlugin) Icon() string { return "🚀" }
+
+func (goPlugin) DetectInstalled() (version, path string, err error) {
+	return detectByCommand("go", "version", func(out string) string {
+		fields := strings.Fields(out)
+		for _, f := range fields {
+			if strings.HasPrefix(f, "go") && len(f) > 2 {
+				return strings.TrimPrefix(f, "go")
+			}
+		}
+		return out
+	})
+}
+
+func (goPlugin) AvailableVersions(ctx context.Context) ([]string, error) {
+	return formattedVersions(ctx, "go")
+}
+
+func (goPlugin) RecommendedEditors() []huh.Option[string] {
+	return append(baseEditors(), huh.NewOption("GoLand 🎯", "GoLand"))
+}
+
+// detectByCommand runs `name args...`, extracting the version from its
+// output with extract, and resolving name's absolute path on PATH.
+func detectByCommand(name string, args string, extract func(string) string) (version, path string, err error) {
+	path, err = exec.LookPath(name)
+	if err != nil {
+		return "", "", err
+	}
+
+	out, err := exec.Command(name, strings.Fields(args)...).CombinedOutput()
+	if err != nil {
+		return "", "", err
+	}
+	return extract(strings.TrimSpace(string(out))), path, nil
+}
+
+// formattedVersions fetches lang's upstream versions via its vermgr
+// backend and formats them the way the version huh.Select expects, e.g.
+// "1.22.0 (Latest)".
+func formattedVersions(ctx context.Context, lang string) ([]string, error) {
+	manager, ok := vermgr.Get(lang)
+	if !ok {
+		return nil, nil
+	}
+
+	remote, err := manager.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make([]string, len(remote))
+	for i, v := range remote {
+		switch {
+		case v.Latest:
+			versions[i] = v.Version + " (Latest)"
+		case v.LTS:
+			versions[i] = v.Version + " (LTS)"
+		default:
+			versions[i] = v.Version
+		}
+	}
+	return versions, nil
+}
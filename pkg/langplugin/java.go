@@ -0,0 +1,35 @@
+package langplugin
+
+import (
+	"context"
+	"strings"
+
+	"github.com/charmbracelet/huh"
+)
+
+func init() {
+	RegisterLanguage(javaPlugin{})
+}
+
+type javaPlugin struct{}
+
+func (javaPlugin) Name() string { return "Java" }
+func (javaPlugin) Icon() string { return "☕" }
+
+func (javaPlugin) DetectInstalled() (version, path string, err error) {
+	return detectByCommand("java", "--version", func(out string) string {
+		fields := strings.Fields(out)
+		if len(fields) >= 2 {
+			return fields[1]
+		}
+		return out
+	})
+}
+
+func (javaPlugin) AvailableVersions(ctx context.Context) ([]string, error) {
+	return formattedVersions(ctx, "java")
+}
+
+func (javaPlugin) RecommendedEditors() []huh.Option[string] {
+	return append(baseEditors(), huh.NewOption("IntelliJ IDEA ☕", "IntelliJ IDEA"))
+}
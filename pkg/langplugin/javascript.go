@@ -0,0 +1,31 @@
+package langplugin
+
+import (
+	"context"
+	"strings"
+
+	"github.com/charmbracelet/huh"
+)
+
+func init() {
+	RegisterLanguage(javascriptPlugin{})
+}
+
+type javascriptPlugin struct{}
+
+func (javascriptPlugin) Name() string { return "JavaScript" }
+func (javascriptPlugin) Icon() string { return "💫" }
+
+func (javascriptPlugin) DetectInstalled() (version, path string, err error) {
+	return detectByCommand("node", "--version", func(out string) string {
+		return strings.TrimPrefix(out, "v")
+	})
+}
+
+func (javascriptPlugin) AvailableVersions(ctx context.Context) ([]string, error) {
+	return formattedVersions(ctx, "javascript")
+}
+
+func (javascriptPlugin) RecommendedEditors() []huh.Option[string] {
+	return append(baseEditors(), huh.NewOption("WebStorm 🌐", "WebStorm"))
+}
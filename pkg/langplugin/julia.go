@@ -0,0 +1,39 @@
+package langplugin
+
+import (
+	"context"
+	"strings"
+
+	"github.com/charmbracelet/huh"
+)
+
+func init() {
+	RegisterLanguage(juliaPlugin{})
+}
+
+type juliaPlugin struct{}
+
+func (juliaPlugin) Name() string { return "Julia" }
+func (juliaPlugin) Icon() string { return "🔬" }
+
+func (juliaPlugin) DetectInstalled() (version, path string, err error) {
+	return detectByCommand("julia", "--version", func(out string) string {
+		fields := strings.Fields(out)
+		if len(fields) == 0 {
+			return out
+		}
+		return fields[len(fields)-1]
+	})
+}
+
+func (juliaPlugin) AvailableVersions(ctx context.Context) ([]string, error) {
+	return formattedVersions(ctx, "julia")
+}
+
+// RecommendedEditors only offers the generic editors: unlike the other
+// languages, there's no JetBrains IDE (or other provisioner-backed
+// editor) for Julia, and offering JuliaPro here would send users into an
+// editorprov.Provision call with no registered provisioner for it.
+func (juliaPlugin) RecommendedEditors() []huh.Option[string] {
+	return baseEditors()
+}
@@ -0,0 +1,67 @@
+// Package langplugin replaces the hardcoded per-language switch blocks
+// that used to live in main with a small plugin registry, so adding a
+// language is a matter of registering one LanguagePlugin rather than
+// touching every switch in the UI code.
+package langplugin
+
+import (
+	"context"
+
+	"github.com/charmbracelet/huh"
+)
+
+// LanguagePlugin is implemented by each supported language and drives
+// everything the setup UI shows about it.
+type LanguagePlugin interface {
+	// Name is the language's display name, e.g. "Go".
+	Name() string
+	// Icon is the emoji shown next to Name in the language select.
+	Icon() string
+	// DetectInstalled reports the version and path of the toolchain
+	// already on PATH, or a non-nil error if none is found.
+	DetectInstalled() (version, path string, err error)
+	// AvailableVersions lists installable versions, newest first,
+	// formatted for display (e.g. "1.22.0 (Latest)").
+	AvailableVersions(ctx context.Context) ([]string, error)
+	// RecommendedEditors lists the editors offered for this language.
+	RecommendedEditors() []huh.Option[string]
+}
+
+var registry []LanguagePlugin
+
+// RegisterLanguage makes p available through the registry. Built-in
+// plugins call this from an init() in their own file.
+func RegisterLanguage(p LanguagePlugin) {
+	registry = append(registry, p)
+}
+
+// All returns every registered plugin, in registration order.
+func All() []LanguagePlugin {
+	return registry
+}
+
+// Label renders the string shown for p in the language huh.Select, e.g.
+// "Go 🚀".
+func Label(p LanguagePlugin) string {
+	return p.Name() + " " + p.Icon()
+}
+
+// ByLabel finds the plugin whose Label matches label exactly.
+func ByLabel(label string) (LanguagePlugin, bool) {
+	for _, p := range registry {
+		if Label(p) == label {
+			return p, true
+		}
+	}
+	return nil, false
+}
+
+// baseEditors are offered for every language alongside any
+// language-specific additions.
+func baseEditors() []huh.Option[string] {
+	return []huh.Option[string]{
+		huh.NewOption("VS Code 💻", "VS Code").Selected(true),
+		huh.NewOption("Neovim 🔮", "Neovim"),
+		huh.NewOption("Sublime Text ✨", "Sublime Text"),
+	}
+}
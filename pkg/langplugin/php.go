@@ -0,0 +1,35 @@
+package langplugin
+
+import (
+	"context"
+	"strings"
+
+	"github.com/charmbracelet/huh"
+)
+
+func init() {
+	RegisterLanguage(phpPlugin{})
+}
+
+type phpPlugin struct{}
+
+func (phpPlugin) Name() string { return "PHP" }
+func (phpPlugin) Icon() string { return "🐘" }
+
+func (phpPlugin) DetectInstalled() (version, path string, err error) {
+	return detectByCommand("php", "--version", func(out string) string {
+		fields := strings.Fields(out)
+		if len(fields) >= 2 {
+			return fields[1]
+		}
+		return out
+	})
+}
+
+func (phpPlugin) AvailableVersions(ctx context.Context) ([]string, error) {
+	return formattedVersions(ctx, "php")
+}
+
+func (phpPlugin) RecommendedEditors() []huh.Option[string] {
+	return append(baseEditors(), huh.NewOption("PhpStorm 🐘", "PhpStorm"))
+}
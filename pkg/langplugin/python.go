@@ -0,0 +1,35 @@
+package langplugin
+
+import (
+	"context"
+	"strings"
+
+	"github.com/charmbracelet/huh"
+)
+
+func init() {
+	RegisterLanguage(pythonPlugin{})
+}
+
+type pythonPlugin struct{}
+
+func (pythonPlugin) Name() string { return "Python" }
+func (pythonPlugin) Icon() string { return "🐍" }
+
+func (pythonPlugin) DetectInstalled() (version, path string, err error) {
+	return detectByCommand("python3", "--version", func(out string) string {
+		parts := strings.Fields(out)
+		if len(parts) >= 2 {
+			return parts[1]
+		}
+		return out
+	})
+}
+
+func (pythonPlugin) AvailableVersions(ctx context.Context) ([]string, error) {
+	return formattedVersions(ctx, "python")
+}
+
+func (pythonPlugin) RecommendedEditors() []huh.Option[string] {
+	return append(baseEditors(), huh.NewOption("PyCharm 🐍", "PyCharm"))
+}
@@ -0,0 +1,35 @@
+package langplugin
+
+import (
+	"context"
+	"strings"
+
+	"github.com/charmbracelet/huh"
+)
+
+func init() {
+	RegisterLanguage(rubyPlugin{})
+}
+
+type rubyPlugin struct{}
+
+func (rubyPlugin) Name() string { return "Ruby" }
+func (rubyPlugin) Icon() string { return "💎" }
+
+func (rubyPlugin) DetectInstalled() (version, path string, err error) {
+	return detectByCommand("ruby", "--version", func(out string) string {
+		fields := strings.Fields(out)
+		if len(fields) >= 2 {
+			return fields[1]
+		}
+		return out
+	})
+}
+
+func (rubyPlugin) AvailableVersions(ctx context.Context) ([]string, error) {
+	return formattedVersions(ctx, "ruby")
+}
+
+func (rubyPlugin) RecommendedEditors() []huh.Option[string] {
+	return append(baseEditors(), huh.NewOption("RubyMine 💎", "RubyMine"))
+}
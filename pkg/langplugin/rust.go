@@ -0,0 +1,35 @@
+package langplugin
+
+import (
+	"context"
+	"strings"
+
+	"github.com/charmbracelet/huh"
+)
+
+func init() {
+	RegisterLanguage(rustPlugin{})
+}
+
+type rustPlugin struct{}
+
+func (rustPlugin) Name() string { return "Rust" }
+func (rustPlugin) Icon() string { return "🦀" }
+
+func (rustPlugin) DetectInstalled() (version, path string, err error) {
+	return detectByCommand("rustc", "--version", func(out string) string {
+		fields := strings.Fields(out)
+		if len(fields) >= 2 {
+			return fields[1]
+		}
+		return out
+	})
+}
+
+func (rustPlugin) AvailableVersions(ctx context.Context) ([]string, error) {
+	return formattedVersions(ctx, "rust")
+}
+
+func (rustPlugin) RecommendedEditors() []huh.Option[string] {
+	return baseEditors()
+}
@@ -0,0 +1,126 @@
+// Package projectdetect inspects a project directory for signature files
+// (go.mod, package.json, pyproject.toml, ...) and reports which language
+// toolchain the project uses, mirroring how asdf/rtx pin versions from
+// repo files and how prompt segments like oh-my-posh's julia segment
+// activate based on file presence.
+package projectdetect
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// Detected is the language and, when one is pinned by the project, the
+// version projectdetect found for it.
+type Detected struct {
+	Language string // vermgr registry key, e.g. "go"
+	Version  string // pinned version, empty if none was found
+}
+
+// signature associates a language with the files that identify it and
+// the function used to read a pinned version, if any.
+type signature struct {
+	language string
+	globs    []string
+	pinned   func(dir string) string
+}
+
+var signatures = []signature{
+	{"go", []string{"go.mod"}, pinnedFromGoMod},
+	{"rust", []string{"Cargo.toml"}, pinnedFromRustToolchain},
+	{"javascript", []string{"package.json"}, pinnedFromNvmrc},
+	{"python", []string{"pyproject.toml", "requirements.txt"}, pinnedFromPythonVersion},
+	{"java", []string{"pom.xml", "build.gradle"}, pinnedFromJavaVersion},
+	{"julia", []string{"*.jl"}, nil},
+}
+
+// Detect inspects dir for the signature files above and returns the
+// first language it recognizes, along with any pinned version. ok is
+// false when dir contains none of the known signatures.
+func Detect(dir string) (detected Detected, ok bool, err error) {
+	for _, sig := range signatures {
+		matched, err := anyGlobExists(dir, sig.globs)
+		if err != nil {
+			return Detected{}, false, fmt.Errorf("detect %s project: %w", sig.language, err)
+		}
+		if !matched {
+			continue
+		}
+
+		version := ""
+		if sig.pinned != nil {
+			version = sig.pinned(dir)
+		}
+		return Detected{Language: sig.language, Version: version}, true, nil
+	}
+	return Detected{}, false, nil
+}
+
+func anyGlobExists(dir string, globs []string) (bool, error) {
+	for _, pattern := range globs {
+		matches, err := filepath.Glob(filepath.Join(dir, pattern))
+		if err != nil {
+			return false, fmt.Errorf("glob %s: %w", pattern, err)
+		}
+		if len(matches) > 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// readTrimmed returns the trimmed contents of dir/name, or "" if it does
+// not exist or cannot be read.
+func readTrimmed(dir, name string) string {
+	data, err := os.ReadFile(filepath.Join(dir, name))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
+
+var goDirectiveRe = regexp.MustCompile(`(?m)^go\s+(\d+\.\d+(?:\.\d+)?)`)
+
+// pinnedFromGoMod extracts the version from go.mod's `go 1.22` directive,
+// "go"-prefixed to match vermgr's go release versions (e.g. "go1.22.0"),
+// since vermgr's version Select only matches against that format.
+func pinnedFromGoMod(dir string) string {
+	contents := readTrimmed(dir, "go.mod")
+	m := goDirectiveRe.FindStringSubmatch(contents)
+	if m == nil {
+		return ""
+	}
+	return "go" + m[1]
+}
+
+var rustChannelRe = regexp.MustCompile(`channel\s*=\s*"([^"]+)"`)
+
+// pinnedFromRustToolchain extracts the channel from rust-toolchain.toml,
+// or the legacy plain-text rust-toolchain file.
+func pinnedFromRustToolchain(dir string) string {
+	if contents := readTrimmed(dir, "rust-toolchain.toml"); contents != "" {
+		if m := rustChannelRe.FindStringSubmatch(contents); m != nil {
+			return m[1]
+		}
+	}
+	return readTrimmed(dir, "rust-toolchain")
+}
+
+// pinnedFromNvmrc reads the Node version pinned in .nvmrc.
+func pinnedFromNvmrc(dir string) string {
+	return strings.TrimPrefix(readTrimmed(dir, ".nvmrc"), "v")
+}
+
+// pinnedFromPythonVersion reads the Python version pinned in
+// .python-version.
+func pinnedFromPythonVersion(dir string) string {
+	return readTrimmed(dir, ".python-version")
+}
+
+// pinnedFromJavaVersion reads the Java version pinned in .java-version.
+func pinnedFromJavaVersion(dir string) string {
+	return readTrimmed(dir, ".java-version")
+}
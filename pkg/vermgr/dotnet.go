@@ -0,0 +1,189 @@
+package vermgr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+func init() {
+	Register(".net", func() Manager { return &dotnetManager{} })
+}
+
+// dotnetManager installs .NET SDKs from the official release metadata
+// feed at https://dotnetcli.blob.core.windows.net/dotnet, the same
+// source dotnet-install.sh uses.
+type dotnetManager struct{}
+
+func (m *dotnetManager) Name() string { return ".NET" }
+
+type dotnetReleasesIndex struct {
+	ReleasesIndex []struct {
+		ChannelVersion string `json:"channel-version"`
+		ReleasesJSON   string `json:"releases.json"`
+	} `json:"releases-index"`
+}
+
+type dotnetChannelReleases struct {
+	Releases []struct {
+		Sdk struct {
+			Version string          `json:"version"`
+			Files    []dotnetSdkFile `json:"files"`
+		} `json:"sdk"`
+	} `json:"releases"`
+}
+
+type dotnetSdkFile struct {
+	Name string `json:"name"`
+	Rid  string `json:"rid"`
+	URL  string `json:"url"`
+	Hash string `json:"hash"`
+}
+
+func dotnetRID() string {
+	arch := runtime.GOARCH
+	if arch == "amd64" {
+		arch = "x64"
+	}
+	switch runtime.GOOS {
+	case "linux":
+		return "linux-" + arch
+	case "darwin":
+		return "osx-" + arch
+	default:
+		return "win-" + arch
+	}
+}
+
+func fetchDotnetReleasesIndex(ctx context.Context) (*dotnetReleasesIndex, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		"https://dotnetcli.blob.core.windows.net/dotnet/release-metadata/releases-index.json", nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetch dotnet release index: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var index dotnetReleasesIndex
+	if err := json.NewDecoder(resp.Body).Decode(&index); err != nil {
+		return nil, fmt.Errorf("decode dotnet release index: %w", err)
+	}
+	return &index, nil
+}
+
+func fetchDotnetChannelReleases(ctx context.Context, releasesJSON string) (*dotnetChannelReleases, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, releasesJSON, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetch dotnet channel releases: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var releases dotnetChannelReleases
+	if err := json.NewDecoder(resp.Body).Decode(&releases); err != nil {
+		return nil, fmt.Errorf("decode dotnet channel releases: %w", err)
+	}
+	return &releases, nil
+}
+
+func (m *dotnetManager) List(ctx context.Context) ([]RemoteVersion, error) {
+	index, err := fetchDotnetReleasesIndex(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make([]RemoteVersion, 0, len(index.ReleasesIndex))
+	for i, ch := range index.ReleasesIndex {
+		releases, err := fetchDotnetChannelReleases(ctx, ch.ReleasesJSON)
+		if err != nil {
+			return nil, err
+		}
+		if len(releases.Releases) == 0 {
+			continue
+		}
+		versions = append(versions, RemoteVersion{
+			Version: releases.Releases[0].Sdk.Version,
+			Latest:  i == 0,
+		})
+	}
+	return versions, nil
+}
+
+func (m *dotnetManager) Installed() ([]LocalVersion, error) {
+	return installedFromDir(".net")
+}
+
+func (m *dotnetManager) Install(ctx context.Context, version string, progress chan<- int64) error {
+	index, err := fetchDotnetReleasesIndex(ctx)
+	if err != nil {
+		return err
+	}
+
+	rid := dotnetRID()
+	for _, ch := range index.ReleasesIndex {
+		releases, err := fetchDotnetChannelReleases(ctx, ch.ReleasesJSON)
+		if err != nil {
+			return err
+		}
+		for _, r := range releases.Releases {
+			if r.Sdk.Version != version {
+				continue
+			}
+			for _, f := range r.Sdk.Files {
+				if f.Rid == rid && strings.HasSuffix(f.Name, ".tar.gz") {
+					dir, err := VersionsDir(".net")
+					if err != nil {
+						return err
+					}
+					return downloadVerifyExtract(ctx, f.URL, filepath.Join(dir, version), f.Hash, progress)
+				}
+			}
+			return fmt.Errorf("no %s sdk archive found for dotnet %s", rid, version)
+		}
+	}
+	return fmt.Errorf("dotnet sdk %s not found", version)
+}
+
+func (m *dotnetManager) Use(version string) error {
+	dir, err := VersionsDir(".net")
+	if err != nil {
+		return err
+	}
+	versionDir := filepath.Join(dir, version)
+
+	// Unlike the other toolchains, the SDK tarball unpacks flat with the
+	// dotnet host at its root rather than under a bin/ subdirectory, so
+	// we link one into place to match the bin/ convention EnvScript
+	// expects for every language's current symlink.
+	binDir := filepath.Join(versionDir, "bin")
+	if err := os.MkdirAll(binDir, 0o755); err != nil {
+		return fmt.Errorf("create %s: %w", binDir, err)
+	}
+	link := filepath.Join(binDir, "dotnet")
+	if _, err := os.Lstat(link); os.IsNotExist(err) {
+		if err := os.Symlink(filepath.Join("..", "dotnet"), link); err != nil {
+			return fmt.Errorf("link dotnet host: %w", err)
+		}
+	}
+
+	return switchCurrent(".net", versionDir)
+}
+
+func (m *dotnetManager) Uninstall(version string) error {
+	dir, err := VersionsDir(".net")
+	if err != nil {
+		return err
+	}
+	return os.RemoveAll(filepath.Join(dir, version))
+}
@@ -0,0 +1,177 @@
+package vermgr
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// installedFromDir lists the subdirectories of a language's versions
+// directory as LocalVersions, marking the one the current symlink
+// resolves into as active.
+func installedFromDir(lang string) ([]LocalVersion, error) {
+	dir, err := VersionsDir(lang)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", dir, err)
+	}
+
+	link, err := CurrentLink(lang)
+	if err != nil {
+		return nil, err
+	}
+	active, _ := filepath.EvalSymlinks(link)
+
+	versions := make([]LocalVersion, 0, len(entries))
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		path := filepath.Join(dir, e.Name())
+		versions = append(versions, LocalVersion{
+			Version: e.Name(),
+			Path:    path,
+			Active:  active == path || strings.HasPrefix(active, path+string(filepath.Separator)),
+		})
+	}
+	return versions, nil
+}
+
+// switchCurrent repoints the language's current symlink at target.
+func switchCurrent(lang, target string) error {
+	link, err := CurrentLink(lang)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(link), 0o755); err != nil {
+		return fmt.Errorf("create current dir: %w", err)
+	}
+	_ = os.Remove(link)
+	if err := os.Symlink(target, link); err != nil {
+		return fmt.Errorf("link %s: %w", target, err)
+	}
+	return nil
+}
+
+// downloadVerifyExtract downloads url, checks its SHA256 against
+// wantSHA256 when non-empty, and extracts the resulting tar.gz into
+// dest. progress, when non-nil, receives cumulative bytes written.
+func downloadVerifyExtract(ctx context.Context, url, dest, wantSHA256 string, progress chan<- int64) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("download %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("download %s: unexpected status %s", url, resp.Status)
+	}
+
+	tmp, err := os.CreateTemp("", "clearlinux-cli-*.tar.gz")
+	if err != nil {
+		return fmt.Errorf("create temp file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	hasher := sha256.New()
+	var written int64
+	buf := make([]byte, 32*1024)
+	for {
+		n, rerr := resp.Body.Read(buf)
+		if n > 0 {
+			if _, werr := tmp.Write(buf[:n]); werr != nil {
+				return fmt.Errorf("write temp file: %w", werr)
+			}
+			hasher.Write(buf[:n])
+			written += int64(n)
+			if progress != nil {
+				progress <- written
+			}
+		}
+		if rerr == io.EOF {
+			break
+		}
+		if rerr != nil {
+			return fmt.Errorf("download %s: %w", url, rerr)
+		}
+	}
+
+	if wantSHA256 != "" {
+		if got := hex.EncodeToString(hasher.Sum(nil)); got != wantSHA256 {
+			return fmt.Errorf("checksum mismatch for %s: got %s, want %s", url, got, wantSHA256)
+		}
+	}
+
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("rewind temp file: %w", err)
+	}
+	return extractTarGz(tmp, dest)
+}
+
+// extractTarGz extracts a gzip-compressed tarball from r into dest,
+// creating dest if needed.
+func extractTarGz(r io.Reader, dest string) error {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return fmt.Errorf("open gzip stream: %w", err)
+	}
+	defer gz.Close()
+
+	if err := os.MkdirAll(dest, 0o755); err != nil {
+		return fmt.Errorf("create %s: %w", dest, err)
+	}
+
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("read tar entry: %w", err)
+		}
+
+		target := filepath.Join(dest, hdr.Name)
+		if rel, err := filepath.Rel(dest, target); err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return fmt.Errorf("tar entry %q escapes destination %s", hdr.Name, dest)
+		}
+
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0o755); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+				return err
+			}
+			out, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, os.FileMode(hdr.Mode))
+			if err != nil {
+				return fmt.Errorf("create %s: %w", target, err)
+			}
+			if _, err := io.Copy(out, tr); err != nil {
+				out.Close()
+				return fmt.Errorf("write %s: %w", target, err)
+			}
+			out.Close()
+		}
+	}
+}
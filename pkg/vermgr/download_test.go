@@ -0,0 +1,70 @@
+package vermgr
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// tarGzOf builds a gzip-compressed tarball containing a single entry
+// named name with the given contents, without validating the name - the
+// escape checks under test are extractTarGz's responsibility, not ours.
+func tarGzOf(t *testing.T, name string, contents string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+
+	if err := tw.WriteHeader(&tar.Header{
+		Name: name,
+		Mode: 0o644,
+		Size: int64(len(contents)),
+	}); err != nil {
+		t.Fatalf("write tar header: %v", err)
+	}
+	if _, err := tw.Write([]byte(contents)); err != nil {
+		t.Fatalf("write tar contents: %v", err)
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatalf("close tar writer: %v", err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatalf("close gzip writer: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestExtractTarGzRejectsPathEscape(t *testing.T) {
+	dest := t.TempDir()
+	archive := tarGzOf(t, "../escaped.txt", "pwned")
+
+	err := extractTarGz(bytes.NewReader(archive), dest)
+	if err == nil {
+		t.Fatal("expected an error for a tar entry escaping dest, got nil")
+	}
+
+	if _, statErr := os.Stat(filepath.Join(filepath.Dir(dest), "escaped.txt")); !os.IsNotExist(statErr) {
+		t.Fatal("tar entry escaped dest and was written to disk")
+	}
+}
+
+func TestExtractTarGzAllowsEntriesWithinDest(t *testing.T) {
+	dest := t.TempDir()
+	archive := tarGzOf(t, "bin/tool", "ok")
+
+	if err := extractTarGz(bytes.NewReader(archive), dest); err != nil {
+		t.Fatalf("extractTarGz: %v", err)
+	}
+
+	got, err := os.ReadFile(filepath.Join(dest, "bin", "tool"))
+	if err != nil {
+		t.Fatalf("read extracted file: %v", err)
+	}
+	if string(got) != "ok" {
+		t.Fatalf("extracted contents = %q, want %q", got, "ok")
+	}
+}
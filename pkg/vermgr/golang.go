@@ -0,0 +1,120 @@
+package vermgr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+)
+
+func init() {
+	Register("go", func() Manager { return &goManager{} })
+}
+
+// goManager installs Go toolchains from the official release index at
+// https://go.dev/dl/?mode=json.
+type goManager struct{}
+
+func (m *goManager) Name() string { return "Go" }
+
+type goRelease struct {
+	Version string        `json:"version"`
+	Stable  bool          `json:"stable"`
+	Files   []goReleaseFile `json:"files"`
+}
+
+type goReleaseFile struct {
+	Filename string `json:"filename"`
+	OS       string `json:"os"`
+	Arch     string `json:"arch"`
+	SHA256   string `json:"sha256"`
+	Kind     string `json:"kind"`
+}
+
+func fetchGoReleases(ctx context.Context) ([]goRelease, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://go.dev/dl/?mode=json&include=all", nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetch go release index: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var releases []goRelease
+	if err := json.NewDecoder(resp.Body).Decode(&releases); err != nil {
+		return nil, fmt.Errorf("decode go release index: %w", err)
+	}
+	return releases, nil
+}
+
+func (m *goManager) List(ctx context.Context) ([]RemoteVersion, error) {
+	releases, err := fetchGoReleases(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make([]RemoteVersion, 0, len(releases))
+	for i, r := range releases {
+		versions = append(versions, RemoteVersion{
+			Version: r.Version,
+			Latest:  i == 0 && r.Stable,
+		})
+	}
+	return versions, nil
+}
+
+func (m *goManager) Installed() ([]LocalVersion, error) {
+	return installedFromDir("go")
+}
+
+func (m *goManager) Install(ctx context.Context, version string, progress chan<- int64) error {
+	releases, err := fetchGoReleases(ctx)
+	if err != nil {
+		return err
+	}
+
+	var file *goReleaseFile
+	for _, r := range releases {
+		if r.Version != version {
+			continue
+		}
+		for i := range r.Files {
+			f := &r.Files[i]
+			if f.OS == runtime.GOOS && f.Arch == runtime.GOARCH && f.Kind == "archive" {
+				file = f
+				break
+			}
+		}
+	}
+	if file == nil {
+		return fmt.Errorf("no %s/%s archive found for go %s", runtime.GOOS, runtime.GOARCH, version)
+	}
+
+	dir, err := VersionsDir("go")
+	if err != nil {
+		return err
+	}
+	return downloadVerifyExtract(ctx, "https://go.dev/dl/"+file.Filename, filepath.Join(dir, version), file.SHA256, progress)
+}
+
+func (m *goManager) Use(version string) error {
+	dir, err := VersionsDir("go")
+	if err != nil {
+		return err
+	}
+	// The official tarball unpacks into a top-level "go" directory.
+	return switchCurrent("go", filepath.Join(dir, version, "go"))
+}
+
+func (m *goManager) Uninstall(version string) error {
+	dir, err := VersionsDir("go")
+	if err != nil {
+		return err
+	}
+	return os.RemoveAll(filepath.Join(dir, version))
+}
@@ -0,0 +1,136 @@
+package vermgr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+)
+
+func init() {
+	Register("java", func() Manager { return &javaManager{} })
+}
+
+// javaManager installs Eclipse Temurin (Adoptium) JDKs via the Adoptium
+// API at https://api.adoptium.net.
+type javaManager struct{}
+
+func (m *javaManager) Name() string { return "Java" }
+
+// javaFeatureVersions are the LTS and current feature releases surfaced
+// to the user; Adoptium does not offer a single "all versions" endpoint.
+var javaFeatureVersions = []int{21, 17, 11}
+
+type adoptiumAsset struct {
+	Version struct {
+		Semver string `json:"semver"`
+	} `json:"version"`
+	Binary struct {
+		Package struct {
+			Link     string `json:"link"`
+			Checksum string `json:"checksum"`
+		} `json:"package"`
+	} `json:"binary"`
+}
+
+func adoptiumArch() string {
+	if runtime.GOARCH == "amd64" {
+		return "x64"
+	}
+	return runtime.GOARCH
+}
+
+func fetchAdoptiumAsset(ctx context.Context, feature int) (*adoptiumAsset, error) {
+	url := fmt.Sprintf(
+		"https://api.adoptium.net/v3/assets/latest/%d/hotspot?architecture=%s&image_type=jdk&os=%s&vendor=eclipse",
+		feature, adoptiumArch(), runtime.GOOS)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetch adoptium release for java %d: %w", feature, err)
+	}
+	defer resp.Body.Close()
+
+	var assets []adoptiumAsset
+	if err := json.NewDecoder(resp.Body).Decode(&assets); err != nil {
+		return nil, fmt.Errorf("decode adoptium release for java %d: %w", feature, err)
+	}
+	if len(assets) == 0 {
+		return nil, fmt.Errorf("no adoptium build found for java %d", feature)
+	}
+	return &assets[0], nil
+}
+
+func (m *javaManager) List(ctx context.Context) ([]RemoteVersion, error) {
+	versions := make([]RemoteVersion, 0, len(javaFeatureVersions))
+	for i, feature := range javaFeatureVersions {
+		asset, err := fetchAdoptiumAsset(ctx, feature)
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, RemoteVersion{
+			Version: asset.Version.Semver,
+			LTS:     feature == 21 || feature == 17 || feature == 11,
+			Latest:  i == 0,
+		})
+	}
+	return versions, nil
+}
+
+func (m *javaManager) Installed() ([]LocalVersion, error) {
+	return installedFromDir("java")
+}
+
+func (m *javaManager) Install(ctx context.Context, version string, progress chan<- int64) error {
+	var asset *adoptiumAsset
+	for _, feature := range javaFeatureVersions {
+		a, err := fetchAdoptiumAsset(ctx, feature)
+		if err != nil {
+			return err
+		}
+		if a.Version.Semver == version {
+			asset = a
+			break
+		}
+	}
+	if asset == nil {
+		return fmt.Errorf("no adoptium build found for java %s", version)
+	}
+
+	dir, err := VersionsDir("java")
+	if err != nil {
+		return err
+	}
+	return downloadVerifyExtract(ctx, asset.Binary.Package.Link, filepath.Join(dir, version), asset.Binary.Package.Checksum, progress)
+}
+
+func (m *javaManager) Use(version string) error {
+	dir, err := VersionsDir("java")
+	if err != nil {
+		return err
+	}
+	// Temurin tarballs unpack into a single top-level jdk-<ver> directory.
+	entries, err := os.ReadDir(filepath.Join(dir, version))
+	if err != nil {
+		return fmt.Errorf("read installed java %s: %w", version, err)
+	}
+	if len(entries) != 1 {
+		return fmt.Errorf("unexpected java %s install layout", version)
+	}
+	return switchCurrent("java", filepath.Join(dir, version, entries[0].Name()))
+}
+
+func (m *javaManager) Uninstall(version string) error {
+	dir, err := VersionsDir("java")
+	if err != nil {
+		return err
+	}
+	return os.RemoveAll(filepath.Join(dir, version))
+}
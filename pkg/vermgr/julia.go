@@ -0,0 +1,145 @@
+package vermgr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+)
+
+func init() {
+	Register("julia", func() Manager { return &juliaManager{} })
+}
+
+// juliaManager installs Julia toolchains from the official release
+// index at https://julialang-s3.julialang.org/bin/versions.json.
+type juliaManager struct{}
+
+func (m *juliaManager) Name() string { return "Julia" }
+
+type juliaFile struct {
+	OS     string `json:"os"`
+	Arch   string `json:"arch"`
+	Kind   string `json:"kind"`
+	URL    string `json:"url"`
+	SHA256 string `json:"sha256"`
+}
+
+type juliaRelease struct {
+	Stable bool        `json:"stable"`
+	Files  []juliaFile `json:"files"`
+}
+
+func juliaArch() string {
+	if runtime.GOARCH == "arm64" {
+		return "aarch64"
+	}
+	return "x86_64"
+}
+
+func fetchJuliaReleases(ctx context.Context) (map[string]juliaRelease, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://julialang-s3.julialang.org/bin/versions.json", nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetch julia release index: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var releases map[string]juliaRelease
+	if err := json.NewDecoder(resp.Body).Decode(&releases); err != nil {
+		return nil, fmt.Errorf("decode julia release index: %w", err)
+	}
+	return releases, nil
+}
+
+func (m *juliaManager) List(ctx context.Context) ([]RemoteVersion, error) {
+	releases, err := fetchJuliaReleases(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make([]string, 0, len(releases))
+	for v := range releases {
+		versions = append(versions, v)
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		if c := compareRustVersions(versions[i], versions[j]); c != 0 {
+			return c > 0
+		}
+		return versions[i] < versions[j] // final release before its prereleases
+	})
+
+	out := make([]RemoteVersion, 0, len(versions))
+	latestSeen := false
+	for _, v := range versions {
+		latest := !latestSeen && releases[v].Stable
+		latestSeen = latestSeen || latest
+		out = append(out, RemoteVersion{Version: v, Latest: latest})
+	}
+	return out, nil
+}
+
+func (m *juliaManager) Installed() ([]LocalVersion, error) {
+	return installedFromDir("julia")
+}
+
+func (m *juliaManager) Install(ctx context.Context, version string, progress chan<- int64) error {
+	releases, err := fetchJuliaReleases(ctx)
+	if err != nil {
+		return err
+	}
+	release, ok := releases[version]
+	if !ok {
+		return fmt.Errorf("julia version %s not found", version)
+	}
+
+	arch := juliaArch()
+	var file *juliaFile
+	for i := range release.Files {
+		f := &release.Files[i]
+		if f.OS == runtime.GOOS && f.Arch == arch && f.Kind == "archive" {
+			file = f
+			break
+		}
+	}
+	if file == nil {
+		return fmt.Errorf("no %s/%s archive found for julia %s", runtime.GOOS, arch, version)
+	}
+
+	dir, err := VersionsDir("julia")
+	if err != nil {
+		return err
+	}
+	return downloadVerifyExtract(ctx, file.URL, filepath.Join(dir, version), file.SHA256, progress)
+}
+
+func (m *juliaManager) Use(version string) error {
+	dir, err := VersionsDir("julia")
+	if err != nil {
+		return err
+	}
+	// The official tarball unpacks into a single top-level julia-<ver> dir.
+	entries, err := os.ReadDir(filepath.Join(dir, version))
+	if err != nil {
+		return fmt.Errorf("read installed julia %s: %w", version, err)
+	}
+	if len(entries) != 1 {
+		return fmt.Errorf("unexpected julia %s install layout", version)
+	}
+	return switchCurrent("julia", filepath.Join(dir, version, entries[0].Name()))
+}
+
+func (m *juliaManager) Uninstall(version string) error {
+	dir, err := VersionsDir("julia")
+	if err != nil {
+		return err
+	}
+	return os.RemoveAll(filepath.Join(dir, version))
+}
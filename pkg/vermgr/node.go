@@ -0,0 +1,154 @@
+package vermgr
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+func init() {
+	Register("javascript", func() Manager { return &nodeManager{} })
+}
+
+// nodeManager installs Node.js toolchains from the official release
+// index at https://nodejs.org/dist/index.json.
+type nodeManager struct{}
+
+func (m *nodeManager) Name() string { return "JavaScript" }
+
+type nodeRelease struct {
+	Version string   `json:"version"`
+	LTS     jsonBool `json:"lts"`
+	Files   []string `json:"files"`
+}
+
+// jsonBool decodes Node's index.json "lts" field, which is either false
+// or a codename string.
+type jsonBool bool
+
+func (b *jsonBool) UnmarshalJSON(data []byte) error {
+	*b = string(data) != `false`
+	return nil
+}
+
+func fetchNodeReleases(ctx context.Context) ([]nodeRelease, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://nodejs.org/dist/index.json", nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetch node release index: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var releases []nodeRelease
+	if err := json.NewDecoder(resp.Body).Decode(&releases); err != nil {
+		return nil, fmt.Errorf("decode node release index: %w", err)
+	}
+	return releases, nil
+}
+
+func (m *nodeManager) List(ctx context.Context) ([]RemoteVersion, error) {
+	releases, err := fetchNodeReleases(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make([]RemoteVersion, 0, len(releases))
+	for i, r := range releases {
+		versions = append(versions, RemoteVersion{
+			Version: strings.TrimPrefix(r.Version, "v"),
+			LTS:     bool(r.LTS),
+			Latest:  i == 0,
+		})
+	}
+	return versions, nil
+}
+
+func (m *nodeManager) Installed() ([]LocalVersion, error) {
+	return installedFromDir("javascript")
+}
+
+func nodeArch() string {
+	if runtime.GOARCH == "amd64" {
+		return "x64"
+	}
+	return runtime.GOARCH
+}
+
+func (m *nodeManager) Install(ctx context.Context, version string, progress chan<- int64) error {
+	tag := "v" + version
+	archive := fmt.Sprintf("node-%s-%s-%s.tar.gz", tag, runtime.GOOS, nodeArch())
+	url := fmt.Sprintf("https://nodejs.org/dist/%s/%s", tag, archive)
+
+	shasums, err := fetchNodeSHASUMS(ctx, tag)
+	if err != nil {
+		return err
+	}
+
+	dir, err := VersionsDir("javascript")
+	if err != nil {
+		return err
+	}
+	return downloadVerifyExtract(ctx, url, filepath.Join(dir, version), shasums[archive], progress)
+}
+
+// fetchNodeSHASUMS parses the plain-text SHASUMS256.txt published
+// alongside each release into a filename -> sha256 map.
+func fetchNodeSHASUMS(ctx context.Context, tag string) (map[string]string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("https://nodejs.org/dist/%s/SHASUMS256.txt", tag), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetch node checksums: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var body bytes.Buffer
+	if _, err := body.ReadFrom(resp.Body); err != nil {
+		return nil, fmt.Errorf("read node checksums: %w", err)
+	}
+
+	sums := map[string]string{}
+	for _, line := range strings.Split(body.String(), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			continue
+		}
+		sums[fields[1]] = fields[0]
+	}
+	return sums, nil
+}
+
+func (m *nodeManager) Use(version string) error {
+	dir, err := VersionsDir("javascript")
+	if err != nil {
+		return err
+	}
+	// The official tarball unpacks into a single top-level node-v<ver>-<os>-<arch> dir.
+	entries, err := os.ReadDir(filepath.Join(dir, version))
+	if err != nil {
+		return fmt.Errorf("read installed node %s: %w", version, err)
+	}
+	if len(entries) != 1 {
+		return fmt.Errorf("unexpected node %s install layout", version)
+	}
+	return switchCurrent("javascript", filepath.Join(dir, version, entries[0].Name()))
+}
+
+func (m *nodeManager) Uninstall(version string) error {
+	dir, err := VersionsDir("javascript")
+	if err != nil {
+		return err
+	}
+	return os.RemoveAll(filepath.Join(dir, version))
+}
@@ -0,0 +1,141 @@
+package vermgr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+)
+
+func init() {
+	Register("php", func() Manager { return &phpManager{} })
+}
+
+// phpManager installs statically-linked PHP CLI builds from
+// crazywhalecc/static-php-cli releases, since PHP itself only publishes
+// source tarballs.
+type phpManager struct{}
+
+func (m *phpManager) Name() string { return "PHP" }
+
+var phpAssetRe = regexp.MustCompile(`^php-(\d+\.\d+\.\d+)-cli-`)
+
+func fetchPHPReleases(ctx context.Context) ([]ghRelease, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		"https://api.github.com/repos/crazywhalecc/static-php-cli/releases?per_page=30", nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetch php release index: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var releases []ghRelease
+	if err := json.NewDecoder(resp.Body).Decode(&releases); err != nil {
+		return nil, fmt.Errorf("decode php release index: %w", err)
+	}
+	return releases, nil
+}
+
+func phpAssetSuffix() string {
+	arch := runtime.GOARCH
+	if arch == "amd64" {
+		arch = "x86_64"
+	}
+	switch runtime.GOOS {
+	case "linux":
+		return "linux-" + arch + ".tar.gz"
+	case "darwin":
+		return "macos-" + arch + ".tar.gz"
+	default:
+		return "windows-" + arch + ".zip"
+	}
+}
+
+func (m *phpManager) List(ctx context.Context) ([]RemoteVersion, error) {
+	releases, err := fetchPHPReleases(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[string]bool{}
+	var versions []RemoteVersion
+	for _, r := range releases {
+		for _, a := range r.Assets {
+			mt := phpAssetRe.FindStringSubmatch(a.Name)
+			if mt == nil || seen[mt[1]] {
+				continue
+			}
+			seen[mt[1]] = true
+			versions = append(versions, RemoteVersion{Version: mt[1], Latest: len(versions) == 0})
+		}
+	}
+	return versions, nil
+}
+
+func (m *phpManager) Installed() ([]LocalVersion, error) {
+	return installedFromDir("php")
+}
+
+func (m *phpManager) Install(ctx context.Context, version string, progress chan<- int64) error {
+	releases, err := fetchPHPReleases(ctx)
+	if err != nil {
+		return err
+	}
+
+	suffix := phpAssetSuffix()
+	wantName := fmt.Sprintf("php-%s-cli-%s", version, suffix)
+	var url string
+	for _, r := range releases {
+		for _, a := range r.Assets {
+			if a.Name == wantName {
+				url = a.BrowserDownloadURL
+			}
+		}
+	}
+	if url == "" {
+		return fmt.Errorf("no %s build found for php %s", suffix, version)
+	}
+
+	dir, err := VersionsDir("php")
+	if err != nil {
+		return err
+	}
+	// static-php-cli does not publish per-asset checksums, so integrity
+	// relies on TLS only, as with the python-build-standalone backend.
+	return downloadVerifyExtract(ctx, url, filepath.Join(dir, version), "", progress)
+}
+
+func (m *phpManager) Use(version string) error {
+	dir, err := VersionsDir("php")
+	if err != nil {
+		return err
+	}
+	// static-php-cli archives unpack flat, with the php binary at the
+	// top level rather than under bin/.
+	binDir := filepath.Join(dir, version, "bin")
+	if err := os.MkdirAll(binDir, 0o755); err != nil {
+		return fmt.Errorf("create %s: %w", binDir, err)
+	}
+	link := filepath.Join(binDir, "php")
+	if _, err := os.Lstat(link); os.IsNotExist(err) {
+		if err := os.Symlink(filepath.Join("..", "php"), link); err != nil {
+			return fmt.Errorf("link php binary: %w", err)
+		}
+	}
+	return switchCurrent("php", filepath.Join(dir, version))
+}
+
+func (m *phpManager) Uninstall(version string) error {
+	dir, err := VersionsDir("php")
+	if err != nil {
+		return err
+	}
+	return os.RemoveAll(filepath.Join(dir, version))
+}
@@ -0,0 +1,140 @@
+package vermgr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+)
+
+func init() {
+	Register("python", func() Manager { return &pythonManager{} })
+}
+
+// pythonManager installs relocatable CPython builds from the
+// python-build-standalone project releases, which publish prebuilt
+// GOOS/GOARCH tarballs that plain python.org source releases do not.
+type pythonManager struct{}
+
+func (m *pythonManager) Name() string { return "Python" }
+
+type ghAsset struct {
+	Name               string `json:"name"`
+	BrowserDownloadURL string `json:"browser_download_url"`
+}
+
+type ghRelease struct {
+	TagName string    `json:"tag_name"`
+	Assets  []ghAsset `json:"assets"`
+}
+
+var pythonVersionRe = regexp.MustCompile(`cpython-(\d+\.\d+\.\d+)\+\d+-`)
+
+func fetchPythonReleases(ctx context.Context) ([]ghRelease, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		"https://api.github.com/repos/indygreg/python-build-standalone/releases?per_page=10", nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetch python release index: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var releases []ghRelease
+	if err := json.NewDecoder(resp.Body).Decode(&releases); err != nil {
+		return nil, fmt.Errorf("decode python release index: %w", err)
+	}
+	return releases, nil
+}
+
+func pythonAssetSuffix() string {
+	arch := runtime.GOARCH
+	if arch == "amd64" {
+		arch = "x86_64"
+	}
+	switch runtime.GOOS {
+	case "linux":
+		return arch + "-unknown-linux-gnu-install_only.tar.gz"
+	case "darwin":
+		return arch + "-apple-darwin-install_only.tar.gz"
+	default:
+		return arch + "-pc-windows-msvc-install_only.tar.gz"
+	}
+}
+
+func (m *pythonManager) List(ctx context.Context) ([]RemoteVersion, error) {
+	releases, err := fetchPythonReleases(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[string]bool{}
+	var versions []RemoteVersion
+	for _, r := range releases {
+		for _, a := range r.Assets {
+			m := pythonVersionRe.FindStringSubmatch(a.Name)
+			if m == nil || seen[m[1]] {
+				continue
+			}
+			seen[m[1]] = true
+			versions = append(versions, RemoteVersion{Version: m[1], Latest: len(versions) == 0})
+		}
+	}
+	return versions, nil
+}
+
+func (m *pythonManager) Installed() ([]LocalVersion, error) {
+	return installedFromDir("python")
+}
+
+func (m *pythonManager) Install(ctx context.Context, version string, progress chan<- int64) error {
+	releases, err := fetchPythonReleases(ctx)
+	if err != nil {
+		return err
+	}
+
+	suffix := pythonAssetSuffix()
+	var url string
+	for _, r := range releases {
+		for _, a := range r.Assets {
+			m := pythonVersionRe.FindStringSubmatch(a.Name)
+			if m != nil && m[1] == version && len(a.Name) > len(suffix) && a.Name[len(a.Name)-len(suffix):] == suffix {
+				url = a.BrowserDownloadURL
+			}
+		}
+	}
+	if url == "" {
+		return fmt.Errorf("no %s build found for python %s", suffix, version)
+	}
+
+	dir, err := VersionsDir("python")
+	if err != nil {
+		return err
+	}
+	// python-build-standalone does not publish per-asset checksums in
+	// the release API, so integrity relies on TLS only.
+	return downloadVerifyExtract(ctx, url, filepath.Join(dir, version), "", progress)
+}
+
+func (m *pythonManager) Use(version string) error {
+	dir, err := VersionsDir("python")
+	if err != nil {
+		return err
+	}
+	// install_only archives unpack into a top-level "python" directory.
+	return switchCurrent("python", filepath.Join(dir, version, "python"))
+}
+
+func (m *pythonManager) Uninstall(version string) error {
+	dir, err := VersionsDir("python")
+	if err != nil {
+		return err
+	}
+	return os.RemoveAll(filepath.Join(dir, version))
+}
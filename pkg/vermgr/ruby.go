@@ -0,0 +1,130 @@
+package vermgr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+)
+
+func init() {
+	Register("ruby", func() Manager { return &rubyManager{} })
+}
+
+// rubyManager installs prebuilt Ruby toolchains from ruby/ruby-builder
+// releases, the same toolcache archives actions/setup-ruby uses, since
+// Ruby itself only publishes source tarballs.
+type rubyManager struct{}
+
+func (m *rubyManager) Name() string { return "Ruby" }
+
+var rubyAssetRe = regexp.MustCompile(`^ruby-(\d+\.\d+\.\d+)-`)
+
+func fetchRubyReleases(ctx context.Context) ([]ghRelease, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		"https://api.github.com/repos/ruby/ruby-builder/releases?per_page=30", nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetch ruby release index: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var releases []ghRelease
+	if err := json.NewDecoder(resp.Body).Decode(&releases); err != nil {
+		return nil, fmt.Errorf("decode ruby release index: %w", err)
+	}
+	return releases, nil
+}
+
+func rubyAssetSuffix() string {
+	arch := runtime.GOARCH
+	switch runtime.GOOS {
+	case "linux":
+		return "ubuntu-22.04.tar.gz"
+	case "darwin":
+		if arch == "arm64" {
+			return "macos-14-arm64.tar.gz"
+		}
+		return "macos-13.tar.gz"
+	default:
+		return "windows-latest.tar.gz"
+	}
+}
+
+func (m *rubyManager) List(ctx context.Context) ([]RemoteVersion, error) {
+	releases, err := fetchRubyReleases(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[string]bool{}
+	var versions []RemoteVersion
+	for _, r := range releases {
+		for _, a := range r.Assets {
+			mt := rubyAssetRe.FindStringSubmatch(a.Name)
+			if mt == nil || seen[mt[1]] {
+				continue
+			}
+			seen[mt[1]] = true
+			versions = append(versions, RemoteVersion{Version: mt[1], Latest: len(versions) == 0})
+		}
+	}
+	return versions, nil
+}
+
+func (m *rubyManager) Installed() ([]LocalVersion, error) {
+	return installedFromDir("ruby")
+}
+
+func (m *rubyManager) Install(ctx context.Context, version string, progress chan<- int64) error {
+	releases, err := fetchRubyReleases(ctx)
+	if err != nil {
+		return err
+	}
+
+	suffix := rubyAssetSuffix()
+	wantName := fmt.Sprintf("ruby-%s-%s", version, suffix)
+	var url string
+	for _, r := range releases {
+		for _, a := range r.Assets {
+			if a.Name == wantName {
+				url = a.BrowserDownloadURL
+			}
+		}
+	}
+	if url == "" {
+		return fmt.Errorf("no %s build found for ruby %s", suffix, version)
+	}
+
+	dir, err := VersionsDir("ruby")
+	if err != nil {
+		return err
+	}
+	// ruby-builder does not publish per-asset checksums, so integrity
+	// relies on TLS only, as with the python-build-standalone backend.
+	return downloadVerifyExtract(ctx, url, filepath.Join(dir, version), "", progress)
+}
+
+func (m *rubyManager) Use(version string) error {
+	dir, err := VersionsDir("ruby")
+	if err != nil {
+		return err
+	}
+	// ruby-builder archives unpack flat, with bin/ at the top level.
+	return switchCurrent("ruby", filepath.Join(dir, version))
+}
+
+func (m *rubyManager) Uninstall(version string) error {
+	dir, err := VersionsDir("ruby")
+	if err != nil {
+		return err
+	}
+	return os.RemoveAll(filepath.Join(dir, version))
+}
@@ -0,0 +1,242 @@
+package vermgr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+)
+
+func init() {
+	Register("rust", func() Manager { return &rustManager{} })
+}
+
+// rustManager installs Rust toolchains from the rustup channel manifests
+// at https://static.rust-lang.org/dist/channel-rust-<channel>.toml, using
+// the stable channel to find the latest release and a version's own
+// manifest to install it.
+type rustManager struct{}
+
+func (m *rustManager) Name() string { return "Rust" }
+
+type rustManifest struct {
+	Version string `toml:"manifest-version"`
+	Pkg     map[string]struct {
+		Version string `toml:"version"`
+		Target  map[string]struct {
+			Available bool   `toml:"available"`
+			URL       string `toml:"url"`
+			Hash      string `toml:"hash"`
+		} `toml:"target"`
+	} `toml:"pkg"`
+}
+
+func rustTarget() string {
+	arch := runtime.GOARCH
+	if arch == "amd64" {
+		arch = "x86_64"
+	} else if arch == "arm64" {
+		arch = "aarch64"
+	}
+	switch runtime.GOOS {
+	case "linux":
+		return arch + "-unknown-linux-gnu"
+	case "darwin":
+		return arch + "-apple-darwin"
+	default:
+		return arch + "-pc-windows-msvc"
+	}
+}
+
+func fetchRustManifest(ctx context.Context, channel string) (*rustManifest, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		fmt.Sprintf("https://static.rust-lang.org/dist/channel-rust-%s.toml", channel), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetch rust manifest: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var manifest rustManifest
+	if _, err := toml.NewDecoder(resp.Body).Decode(&manifest); err != nil {
+		return nil, fmt.Errorf("decode rust manifest: %w", err)
+	}
+	return &manifest, nil
+}
+
+var rustTagRe = regexp.MustCompile(`^\d+\.\d+\.\d+$`)
+
+type rustTag struct {
+	Name string `json:"name"`
+}
+
+// fetchRustVersions lists released rustc versions, newest first, from
+// the rust-lang/rust tags (rustup's per-version archive only serves a
+// manifest for a version you already know the name of, so the tag list
+// is the closest thing to a version index upstream publishes).
+func fetchRustVersions(ctx context.Context) ([]string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		"https://api.github.com/repos/rust-lang/rust/tags?per_page=30", nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetch rust tags: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var tags []rustTag
+	if err := json.NewDecoder(resp.Body).Decode(&tags); err != nil {
+		return nil, fmt.Errorf("decode rust tags: %w", err)
+	}
+
+	versions := make([]string, 0, len(tags))
+	for _, t := range tags {
+		if rustTagRe.MatchString(t.Name) {
+			versions = append(versions, t.Name)
+		}
+	}
+	sort.Slice(versions, func(i, j int) bool { return compareRustVersions(versions[i], versions[j]) > 0 })
+	return versions, nil
+}
+
+// compareRustVersions compares two dotted major.minor.patch versions,
+// returning >0 if a is newer than b.
+func compareRustVersions(a, b string) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		an, _ := strconv.Atoi(as[i])
+		bn, _ := strconv.Atoi(bs[i])
+		if an != bn {
+			return an - bn
+		}
+	}
+	return 0
+}
+
+func (m *rustManager) List(ctx context.Context) ([]RemoteVersion, error) {
+	stable, err := fetchRustManifest(ctx, "stable")
+	if err != nil {
+		return nil, err
+	}
+	rustc, ok := stable.Pkg["rustc"]
+	if !ok {
+		return nil, fmt.Errorf("rust manifest missing rustc package")
+	}
+
+	tagged, err := fetchRustVersions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make([]RemoteVersion, 0, len(tagged))
+	seen := map[string]bool{}
+	for _, v := range tagged {
+		seen[v] = true
+		versions = append(versions, RemoteVersion{Version: v, Latest: v == rustc.Version})
+	}
+	if !seen[rustc.Version] {
+		versions = append([]RemoteVersion{{Version: rustc.Version, Latest: true}}, versions...)
+	}
+	return versions, nil
+}
+
+func (m *rustManager) Installed() ([]LocalVersion, error) {
+	return installedFromDir("rust")
+}
+
+// rustComponents are the rustup manifest packages extracted for every
+// install; cargo is required alongside rustc since the starter-package
+// step drives `cargo add`/`cargo init`.
+var rustComponents = []string{"rustc", "cargo"}
+
+func (m *rustManager) Install(ctx context.Context, version string, progress chan<- int64) error {
+	manifest, err := fetchRustManifest(ctx, version)
+	if err != nil {
+		return err
+	}
+
+	dir, err := VersionsDir("rust")
+	if err != nil {
+		return err
+	}
+	versionDir := filepath.Join(dir, version)
+
+	for _, component := range rustComponents {
+		pkg, ok := manifest.Pkg[component]
+		if !ok {
+			return fmt.Errorf("rust manifest missing %s package", component)
+		}
+		target, ok := pkg.Target[rustTarget()]
+		if !ok || !target.Available {
+			return fmt.Errorf("no %s build found for rust %s component %s", rustTarget(), version, component)
+		}
+		if err := downloadVerifyExtract(ctx, target.URL, filepath.Join(versionDir, component), target.Hash, progress); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *rustManager) Use(version string) error {
+	dir, err := VersionsDir("rust")
+	if err != nil {
+		return err
+	}
+	versionDir := filepath.Join(dir, version)
+
+	// Each component's installer tarball unpacks into its own
+	// <component>-<ver>-<target>/<component> dir; merge their bin/
+	// directories into one, since rustup's own installer would normally
+	// install both into a single sysroot.
+	bin := filepath.Join(versionDir, "bin")
+	if err := os.MkdirAll(bin, 0o755); err != nil {
+		return fmt.Errorf("create %s: %w", bin, err)
+	}
+	for _, component := range rustComponents {
+		componentDir := filepath.Join(versionDir, component)
+		entries, err := os.ReadDir(componentDir)
+		if err != nil {
+			return fmt.Errorf("read installed rust %s component %s: %w", version, component, err)
+		}
+		if len(entries) != 1 {
+			return fmt.Errorf("unexpected rust %s %s install layout", version, component)
+		}
+		componentBin := filepath.Join(componentDir, entries[0].Name(), component, "bin")
+		binEntries, err := os.ReadDir(componentBin)
+		if err != nil {
+			return fmt.Errorf("read %s bin dir: %w", component, err)
+		}
+		for _, be := range binEntries {
+			link := filepath.Join(bin, be.Name())
+			if _, err := os.Lstat(link); os.IsNotExist(err) {
+				if err := os.Symlink(filepath.Join(componentBin, be.Name()), link); err != nil {
+					return fmt.Errorf("link %s: %w", be.Name(), err)
+				}
+			}
+		}
+	}
+
+	return switchCurrent("rust", versionDir)
+}
+
+func (m *rustManager) Uninstall(version string) error {
+	dir, err := VersionsDir("rust")
+	if err != nil {
+		return err
+	}
+	return os.RemoveAll(filepath.Join(dir, version))
+}
@@ -0,0 +1,41 @@
+package vermgr
+
+import "testing"
+
+func TestCompareRustVersions(t *testing.T) {
+	cases := []struct {
+		a, b string
+		want int
+	}{
+		{"1.75.0", "1.75.0", 0},
+		{"1.76.0", "1.75.0", 1},
+		{"1.75.0", "1.76.0", -1},
+		{"1.75.1", "1.75.0", 1},
+		{"2.0.0", "1.99.9", 1},
+	}
+	for _, c := range cases {
+		got := compareRustVersions(c.a, c.b)
+		switch {
+		case c.want > 0 && got <= 0:
+			t.Errorf("compareRustVersions(%q, %q) = %d, want > 0", c.a, c.b, got)
+		case c.want < 0 && got >= 0:
+			t.Errorf("compareRustVersions(%q, %q) = %d, want < 0", c.a, c.b, got)
+		case c.want == 0 && got != 0:
+			t.Errorf("compareRustVersions(%q, %q) = %d, want 0", c.a, c.b, got)
+		}
+	}
+}
+
+func TestRustTagRe(t *testing.T) {
+	cases := map[string]bool{
+		"1.75.0":       true,
+		"1.75.0-beta":  false,
+		"release-1.75": false,
+		"1.75":         false,
+	}
+	for tag, want := range cases {
+		if got := rustTagRe.MatchString(tag); got != want {
+			t.Errorf("rustTagRe.MatchString(%q) = %v, want %v", tag, got, want)
+		}
+	}
+}
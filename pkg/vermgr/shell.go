@@ -0,0 +1,53 @@
+package vermgr
+
+import "fmt"
+
+// Shell identifies a target shell for EnvScript.
+type Shell string
+
+const (
+	Bash       Shell = "bash"
+	Zsh        Shell = "zsh"
+	Fish       Shell = "fish"
+	PowerShell Shell = "powershell"
+)
+
+// EnvScript renders the export statements that prepend every given
+// language's current symlink to PATH, meant to be sourced from a shell
+// rc file, e.g. `eval "$(clearlinux-cli env)"`.
+func EnvScript(shell Shell, langs []string) (string, error) {
+	root, err := DataDir()
+	if err != nil {
+		return "", err
+	}
+
+	bins := make([]string, 0, len(langs))
+	for _, lang := range langs {
+		bins = append(bins, fmt.Sprintf("%s/current/%s/bin", root, lang))
+	}
+
+	switch shell {
+	case Bash, Zsh:
+		return fmt.Sprintf("export PATH=%q\n", joinSep(bins, ":")+":$PATH"), nil
+	case Fish:
+		return fmt.Sprintf("set -gx PATH %s $PATH\n", joinSep(bins, " ")), nil
+	case PowerShell:
+		return fmt.Sprintf("$env:PATH = %q + \";\" + $env:PATH\n", joinSep(bins, ";")), nil
+	default:
+		return "", fmt.Errorf("unsupported shell: %s", shell)
+	}
+}
+
+// joinSep joins paths with sep, the separator each shell expects between
+// PATH entries (":" for POSIX shells, " " for fish's list syntax, ";"
+// for PowerShell).
+func joinSep(paths []string, sep string) string {
+	out := ""
+	for i, p := range paths {
+		if i > 0 {
+			out += sep
+		}
+		out += p
+	}
+	return out
+}
@@ -0,0 +1,53 @@
+package vermgr
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestJoinSep(t *testing.T) {
+	cases := []struct {
+		paths []string
+		sep   string
+		want  string
+	}{
+		{nil, ":", ""},
+		{[]string{"/a/bin"}, ":", "/a/bin"},
+		{[]string{"/a/bin", "/b/bin"}, ":", "/a/bin:/b/bin"},
+		{[]string{"/a/bin", "/b/bin"}, " ", "/a/bin /b/bin"},
+		{[]string{"/a/bin", "/b/bin"}, ";", "/a/bin;/b/bin"},
+	}
+	for _, c := range cases {
+		if got := joinSep(c.paths, c.sep); got != c.want {
+			t.Errorf("joinSep(%v, %q) = %q, want %q", c.paths, c.sep, got, c.want)
+		}
+	}
+}
+
+func TestEnvScriptUnsupportedShell(t *testing.T) {
+	if _, err := EnvScript(Shell("tcsh"), []string{"go"}); err == nil {
+		t.Fatal("expected an error for an unsupported shell, got nil")
+	}
+}
+
+func TestEnvScriptSeparators(t *testing.T) {
+	t.Setenv("XDG_DATA_HOME", "/data")
+
+	cases := []struct {
+		shell    Shell
+		contains string
+	}{
+		{Bash, "/data/clearlinux-cli/current/go/bin:/data/clearlinux-cli/current/rust/bin:$PATH"},
+		{Fish, "/data/clearlinux-cli/current/go/bin /data/clearlinux-cli/current/rust/bin $PATH"},
+		{PowerShell, "/data/clearlinux-cli/current/go/bin;/data/clearlinux-cli/current/rust/bin"},
+	}
+	for _, c := range cases {
+		script, err := EnvScript(c.shell, []string{"go", "rust"})
+		if err != nil {
+			t.Fatalf("EnvScript(%s): %v", c.shell, err)
+		}
+		if !strings.Contains(script, c.contains) {
+			t.Errorf("EnvScript(%s) = %q, want it to contain %q", c.shell, script, c.contains)
+		}
+	}
+}
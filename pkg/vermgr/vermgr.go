@@ -0,0 +1,106 @@
+// Package vermgr is a per-language version manager subsystem that
+// downloads, installs, and switches between upstream toolchain releases,
+// similar in spirit to gvm/nvm/pyenv but driven from a single Go binary.
+package vermgr
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// RemoteVersion describes a version published on a language's upstream
+// release index.
+type RemoteVersion struct {
+	Version string
+	LTS     bool
+	Latest  bool
+}
+
+// LocalVersion describes a version already installed under VersionsDir.
+type LocalVersion struct {
+	Version string
+	Path    string
+	Active  bool
+}
+
+// Manager is implemented by each language backend and drives the full
+// install/switch lifecycle for that language's toolchains.
+type Manager interface {
+	// Name returns the language name as shown in the UI, e.g. "Go".
+	Name() string
+	// List returns the versions published upstream, newest first.
+	List(ctx context.Context) ([]RemoteVersion, error)
+	// Installed returns the versions currently installed locally.
+	Installed() ([]LocalVersion, error)
+	// Install downloads, verifies, and extracts the given version.
+	// progress, when non-nil, receives cumulative bytes written as the
+	// download proceeds.
+	Install(ctx context.Context, version string, progress chan<- int64) error
+	// Use switches the active version by repointing the current symlink.
+	Use(version string) error
+	// Uninstall removes an installed version from disk.
+	Uninstall(version string) error
+}
+
+var registry = map[string]func() Manager{}
+
+// Register makes a backend constructor available under lang (e.g. "go").
+// Backends call this from an init() in their own file.
+func Register(lang string, newFn func() Manager) {
+	registry[lang] = newFn
+}
+
+// Get returns a new Manager for lang, or false if no backend is
+// registered under that name.
+func Get(lang string) (Manager, bool) {
+	newFn, ok := registry[lang]
+	if !ok {
+		return nil, false
+	}
+	return newFn(), true
+}
+
+// Languages returns the names of every registered backend.
+func Languages() []string {
+	names := make([]string, 0, len(registry))
+	for lang := range registry {
+		names = append(names, lang)
+	}
+	return names
+}
+
+// DataDir returns the root directory versions are installed under,
+// honoring XDG_DATA_HOME.
+func DataDir() (string, error) {
+	base := os.Getenv("XDG_DATA_HOME")
+	if base == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("resolve home directory: %w", err)
+		}
+		base = filepath.Join(home, ".local", "share")
+	}
+	return filepath.Join(base, "clearlinux-cli"), nil
+}
+
+// VersionsDir returns the directory a language's installed versions live
+// under, e.g. $XDG_DATA_HOME/clearlinux-cli/versions/go.
+func VersionsDir(lang string) (string, error) {
+	root, err := DataDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(root, "versions", lang), nil
+}
+
+// CurrentLink returns the symlink users add to PATH for the given
+// language, e.g. $XDG_DATA_HOME/clearlinux-cli/current/go.
+func CurrentLink(lang string) (string, error) {
+	root, err := DataDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(root, "current", lang), nil
+}
@@ -0,0 +1,513 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/huh"
+	"github.com/charmbracelet/huh/spinner"
+	"github.com/charmbracelet/lipgloss"
+	xstrings "github.com/charmbracelet/x/exp/strings"
+	"github.com/spf13/cobra"
+
+	"github.com/jdu211171/clearlinux-cli/internal/editorprov"
+	"github.com/jdu211171/clearlinux-cli/internal/pkginstall"
+	"github.com/jdu211171/clearlinux-cli/pkg/langplugin"
+	"github.com/jdu211171/clearlinux-cli/pkg/projectdetect"
+	"github.com/jdu211171/clearlinux-cli/pkg/vermgr"
+)
+
+// DevSetup holds all configuration for the development environment
+type DevSetup struct {
+	Language   Language
+	CreateRepo bool
+}
+
+// Language contains all language-specific settings and state
+type Language struct {
+	Type       string   // Selected programming language
+	Version    string   // Version to install
+	Editors    []string // Selected development editors
+	CurrentVer string   // Currently installed version
+	Path       string   // Installation path
+}
+
+// setupResult is the structured form of the setup summary, emitted when
+// --json is set.
+type setupResult struct {
+	Language        string                     `json:"language"`
+	Version         string                     `json:"version"`
+	Editors         []string                   `json:"editors,omitempty"`
+	DownloadedBytes int64                      `json:"downloadedBytes"`
+	PreviousVersion string                     `json:"previousVersion,omitempty"`
+	Packages        []pkginstall.InstallResult `json:"packages,omitempty"`
+	EditorResults   []editorprov.Result        `json:"editorResults,omitempty"`
+}
+
+var (
+	setupLang    string
+	setupVersion string
+	setupEditors []string
+)
+
+var setupCmd = &cobra.Command{
+	Use:   "setup",
+	Short: "Set up a language toolchain and editors (interactive by default)",
+	RunE:  runSetup,
+}
+
+func init() {
+	setupCmd.Flags().StringVar(&setupLang, "lang", "", "language to set up, e.g. go, python, rust")
+	setupCmd.Flags().StringVar(&setupVersion, "version", "", "version to install")
+	setupCmd.Flags().StringSliceVar(&setupEditors, "editors", nil, "editors to note in the summary, e.g. --editors=VS Code,Neovim")
+}
+
+// runSetup backs both the root command and `setup` subcommand: once
+// --lang and --version are both provided it runs headless, otherwise it
+// falls back to the huh wizard.
+func runSetup(cmd *cobra.Command, args []string) error {
+	if setupLang != "" && setupVersion != "" {
+		return runHeadlessSetup(cmd.Context())
+	}
+	return runInteractiveSetup()
+}
+
+func runHeadlessSetup(ctx context.Context) error {
+	lang := strings.ToLower(setupLang)
+	manager, ok := vermgr.Get(lang)
+	if !ok {
+		return fmt.Errorf("unsupported language: %s", setupLang)
+	}
+
+	downloaded, err := installWithProgress(ctx, manager, setupVersion)
+	if err != nil {
+		return err
+	}
+	if err := manager.Use(setupVersion); err != nil {
+		return fmt.Errorf("switch to %s %s: %w", lang, setupVersion, err)
+	}
+
+	result := setupResult{Language: lang, Version: setupVersion, Editors: setupEditors, DownloadedBytes: downloaded}
+	return printResult(result, func() {
+		fmt.Println(headerStyle.Render("DEV ENVIRONMENT SETUP COMPLETE"))
+		fmt.Printf("Language: %s\nVersion: %s\nDownloaded: %s\n",
+			highlight.Render(lang), highlight.Render(setupVersion), highlight.Render(formatBytes(downloaded)))
+		if len(setupEditors) > 0 {
+			fmt.Printf("Editors: %s\n", highlight.Render(xstrings.EnglishJoin(setupEditors, true)))
+		}
+	})
+}
+
+// installWithProgress drives manager.Install, draining its byte-count
+// progress channel and returning the total bytes downloaded.
+func installWithProgress(ctx context.Context, manager vermgr.Manager, version string) (int64, error) {
+	progress := make(chan int64)
+	drained := make(chan struct{})
+	var downloaded int64
+	go func() {
+		// A backend that downloads several files (rust's rustc and cargo
+		// components) restarts its cumulative count at each new file.
+		var base, last int64
+		for n := range progress {
+			if n < last {
+				base += last
+			}
+			last = n
+			downloaded = base + n
+		}
+		close(drained)
+	}()
+
+	err := manager.Install(ctx, version, progress)
+	close(progress)
+	<-drained
+	if err != nil {
+		return 0, fmt.Errorf("install %s: %w", version, err)
+	}
+	return downloaded, nil
+}
+
+// vermgrKey maps a UI language label such as "Go 🚀" to the registry key
+// its vermgr.Manager backend is registered under, e.g. "go".
+func vermgrKey(language string) string {
+	return strings.ToLower(strings.Split(language, " ")[0])
+}
+
+// languageSelectOptions renders every langplugin.LanguagePlugin as an
+// option for the language huh.Select, in registration order.
+func languageSelectOptions() []huh.Option[string] {
+	plugins := langplugin.All()
+	options := make([]huh.Option[string], len(plugins))
+	for i, p := range plugins {
+		label := langplugin.Label(p)
+		options[i] = huh.NewOption(label, label)
+	}
+	return options
+}
+
+// getAvailableVersions fetches the versions published upstream for a
+// given language via its LanguagePlugin, formatted for display in the
+// version huh.Select (e.g. "1.22.0 (Latest)").
+func getAvailableVersions(ctx context.Context, language string) []huh.Option[string] {
+	p, ok := langplugin.ByLabel(language)
+	if !ok {
+		return nil
+	}
+
+	versions, err := p.AvailableVersions(ctx)
+	if err != nil {
+		return []huh.Option[string]{
+			huh.NewOption(errorStyle.Render("failed to fetch versions: "+err.Error()), ""),
+		}
+	}
+
+	options := make([]huh.Option[string], len(versions))
+	for i, v := range versions {
+		options[i] = huh.NewOption(v, strings.Fields(v)[0])
+	}
+	return options
+}
+
+// getLanguageEditors returns recommended editors for a given language
+// via its LanguagePlugin.
+func getLanguageEditors(language string) []huh.Option[string] {
+	p, ok := langplugin.ByLabel(language)
+	if !ok {
+		return nil
+	}
+	return p.RecommendedEditors()
+}
+
+func runInteractiveSetup() error {
+	var setup DevSetup
+	accessible, _ := strconv.ParseBool(os.Getenv("ACCESSIBLE"))
+	ctx := context.Background()
+
+	// Pre-select the language (and pinned version, if any) detected from
+	// project files in the current directory, so the user can just press
+	// Enter to accept.
+	if cwd, err := os.Getwd(); err == nil {
+		if detected, ok, err := projectdetect.Detect(cwd); err == nil && ok {
+			for _, p := range langplugin.All() {
+				if strings.ToLower(p.Name()) == detected.Language {
+					setup.Language.Type = langplugin.Label(p)
+					setup.Language.Version = detected.Version
+					break
+				}
+			}
+		}
+	}
+
+	// First form: Language selection and version checking
+	languageForm := huh.NewForm(
+		huh.NewGroup(
+			huh.NewSelect[string]().
+				Options(languageSelectOptions()...).
+				Title("Choose a programming language").
+				Value(&setup.Language.Type),
+
+			// Show current version if installed
+			huh.NewNote().
+				TitleFunc(func() string {
+					p, ok := langplugin.ByLabel(setup.Language.Type)
+					if !ok {
+						return subtle.Render("No existing installation found")
+					}
+					ver, path, err := p.DetectInstalled()
+					if err == nil {
+						setup.Language.CurrentVer = ver
+						setup.Language.Path = path
+						return highlight.Render(fmt.Sprintf("Found %s installation:", setup.Language.Type))
+					}
+					return subtle.Render("No existing installation found")
+				}, &setup.Language.Type).
+				DescriptionFunc(func() string {
+					if setup.Language.CurrentVer != "" {
+						return fmt.Sprintf("Version: %s\nPath: %s",
+							highlight.Render(setup.Language.CurrentVer),
+							subtle.Render(setup.Language.Path))
+					}
+					return subtle.Render("You can proceed with a fresh installation")
+				}, &setup.Language.Type),
+
+			// Version selection
+			huh.NewSelect[string]().
+				Title("Choose version to install").
+				OptionsFunc(func() []huh.Option[string] {
+					return getAvailableVersions(ctx, setup.Language.Type)
+				}, &setup.Language.Type).
+				Value(&setup.Language.Version),
+		),
+	).WithAccessible(accessible)
+
+	if err := languageForm.Run(); err != nil {
+		return err
+	}
+
+	// Second form: Editor selection
+	if setup.Language.Type != "" && setup.Language.Version != "" {
+		editorForm := huh.NewForm(
+			huh.NewGroup(
+				huh.NewMultiSelect[string]().
+					Title("Development Editors").
+					OptionsFunc(func() []huh.Option[string] {
+						return getLanguageEditors(setup.Language.Type)
+					}, &setup.Language.Type).
+					Value(&setup.Language.Editors).
+					Filterable(true),
+			),
+		).WithAccessible(accessible)
+
+		if err := editorForm.Run(); err != nil {
+			return err
+		}
+	}
+
+	// Download, verify, extract, and switch to the chosen version
+	var installErr error
+	var downloaded int64
+	setupEnvironment := func() {
+		manager, ok := vermgr.Get(vermgrKey(setup.Language.Type))
+		if !ok {
+			installErr = fmt.Errorf("unsupported language: %s", setup.Language.Type)
+			return
+		}
+
+		n, err := installWithProgress(ctx, manager, setup.Language.Version)
+		downloaded = n
+		if err != nil {
+			installErr = err
+			return
+		}
+
+		if err := manager.Use(setup.Language.Version); err != nil {
+			installErr = fmt.Errorf("switch to %s %s: %w", setup.Language.Type, setup.Language.Version, err)
+		}
+	}
+
+	_ = spinner.New().
+		Title("Setting up your development environment...").
+		Accessible(accessible).
+		Action(setupEnvironment).
+		Run()
+
+	if installErr != nil {
+		return installErr
+	}
+
+	packages, err := offerStarterPackages(ctx, accessible, vermgrKey(setup.Language.Type))
+	if err != nil {
+		return err
+	}
+
+	editorResults := provisionEditors(ctx, accessible, setup.Language.Editors, vermgrKey(setup.Language.Type))
+
+	result := setupResult{
+		Language:        setup.Language.Type,
+		Version:         setup.Language.Version,
+		Editors:         setup.Language.Editors,
+		DownloadedBytes: downloaded,
+		PreviousVersion: setup.Language.CurrentVer,
+		Packages:        packages,
+		EditorResults:   editorResults,
+	}
+
+	return printResult(result, func() {
+		var sb strings.Builder
+		fmt.Fprintf(&sb,
+			"%s\n\nLanguage: %s\nVersion: %s\nDownloaded: %s\nEditors: %s",
+			headerStyle.Render("DEV ENVIRONMENT SETUP COMPLETE"),
+			highlight.Render(setup.Language.Type),
+			highlight.Render(setup.Language.Version),
+			highlight.Render(formatBytes(downloaded)),
+			highlight.Render(xstrings.EnglishJoin(setup.Language.Editors, true)),
+		)
+
+		if setup.Language.CurrentVer != "" {
+			fmt.Fprintf(&sb, "\n\nPrevious version: %s", subtle.Render(setup.Language.CurrentVer))
+		}
+
+		if len(packages) > 0 {
+			names := make([]string, len(packages))
+			for i, p := range packages {
+				names[i] = p.Package
+			}
+			fmt.Fprintf(&sb, "\n\nPackages: %s", highlight.Render(xstrings.EnglishJoin(names, true)))
+		}
+
+		for _, er := range editorResults {
+			if er.Error != "" {
+				fmt.Fprintf(&sb, "\n\n%s: %s", errorStyle.Render(er.Editor), er.Error)
+			}
+		}
+
+		fmt.Println(
+			lipgloss.NewStyle().
+				Width(60).
+				BorderStyle(lipgloss.RoundedBorder()).
+				BorderForeground(lipgloss.Color("63")).
+				Padding(1, 2).
+				Render(sb.String()),
+		)
+	})
+}
+
+// offerStarterPackages lets the user pick from lang's starter package
+// set plus any packages previously recorded for lang, resolves each
+// selection's dependency tree, and records it in the manifest after a
+// confirmation step when OSV reports known vulnerabilities. The packages
+// themselves are not installed into the user's environment.
+func offerStarterPackages(ctx context.Context, accessible bool, lang string) ([]pkginstall.InstallResult, error) {
+	manifest, err := pkginstall.LoadManifest()
+	if err != nil {
+		return nil, err
+	}
+	prior := manifest.ForLanguage(lang)
+
+	options := pkginstall.StarterPackageOptions(lang)
+	var reinstall []string
+	if len(prior) > 0 {
+		priorOptions := make([]huh.Option[string], len(prior))
+		for i, entry := range prior {
+			priorOptions[i] = huh.NewOption(
+				fmt.Sprintf("%s (installed %s)", entry.Package, entry.InstalledAt.Format("2006-01-02")),
+				entry.Package,
+			)
+		}
+		reinstallForm := huh.NewForm(
+			huh.NewGroup(
+				huh.NewMultiSelect[string]().
+					Title("Reinstall previously installed packages?").
+					Description("These were installed by a previous setup run; re-checking them resolves the latest version and re-runs the OSV scan.").
+					Options(priorOptions...).
+					Value(&reinstall),
+			),
+		).WithAccessible(accessible)
+		if err := reinstallForm.Run(); err != nil {
+			return nil, err
+		}
+	}
+	if len(options) == 0 && len(reinstall) == 0 {
+		return nil, nil
+	}
+
+	var selected []string
+	if len(options) > 0 {
+		packageForm := huh.NewForm(
+			huh.NewGroup(
+				huh.NewMultiSelect[string]().
+					Title("Starter packages").
+					Description("Each selection is checked against the OSV vulnerability database before it's confirmed.").
+					Options(options...).
+					Value(&selected),
+			),
+		).WithAccessible(accessible)
+		if err := packageForm.Run(); err != nil {
+			return nil, err
+		}
+	}
+	for _, pkg := range reinstall {
+		if !slices.Contains(selected, pkg) {
+			selected = append(selected, pkg)
+		}
+	}
+
+	var toolchainBin string
+	if toolchainPath, err := vermgr.CurrentLink(lang); err == nil {
+		toolchainBin = filepath.Join(toolchainPath, "bin")
+	}
+
+	var installed []pkginstall.InstallResult
+	for _, pkg := range selected {
+		result, err := pkginstall.Install(ctx, lang, pkg, toolchainBin)
+		if err != nil {
+			return nil, err
+		}
+
+		proceed := true
+		if len(result.Vulnerabilities) > 0 {
+			confirmForm := huh.NewForm(
+				huh.NewGroup(
+					huh.NewConfirm().
+						Title(fmt.Sprintf("%d known vulnerabilities found in %s", countVulnerabilities(result.Vulnerabilities), pkg)).
+						Description(describeVulnerabilities(result.Vulnerabilities)).
+						Affirmative("Install anyway").
+						Negative("Skip").
+						Value(&proceed),
+				),
+			).WithAccessible(accessible)
+			if err := confirmForm.Run(); err != nil {
+				return nil, err
+			}
+		}
+		if !proceed {
+			continue
+		}
+
+		installed = append(installed, result)
+		manifest.Upsert(pkginstall.ManifestEntry{
+			Language:     lang,
+			Package:      pkg,
+			Dependencies: result.Dependencies,
+			InstalledAt:  time.Now(),
+		})
+	}
+
+	if len(installed) > 0 {
+		if err := manifest.Save(); err != nil {
+			return nil, err
+		}
+	}
+	return installed, nil
+}
+
+func countVulnerabilities(vulns map[string][]pkginstall.Vulnerability) int {
+	count := 0
+	for _, v := range vulns {
+		count += len(v)
+	}
+	return count
+}
+
+func describeVulnerabilities(vulns map[string][]pkginstall.Vulnerability) string {
+	var sb strings.Builder
+	for dep, depVulns := range vulns {
+		for _, v := range depVulns {
+			fmt.Fprintf(&sb, "%s: %s (%s)\n", dep, v.ID, v.Summary)
+		}
+	}
+	return subtle.Render(strings.TrimSpace(sb.String()))
+}
+
+// provisionEditors installs each selected editor (if missing) and
+// configures it for lang's freshly installed toolchain, driven by the
+// same spinner used for the toolchain download. A failure on one
+// editor is recorded on its Result rather than aborting the others.
+func provisionEditors(ctx context.Context, accessible bool, editors []string, lang string) []editorprov.Result {
+	if len(editors) == 0 {
+		return nil
+	}
+
+	toolchainPath, err := vermgr.CurrentLink(lang)
+	if err != nil {
+		toolchainPath = ""
+	}
+
+	var results []editorprov.Result
+	_ = spinner.New().
+		Title("Provisioning editors...").
+		Accessible(accessible).
+		Action(func() {
+			for _, editor := range editors {
+				results = append(results, editorprov.Provision(ctx, editor, lang, toolchainPath))
+			}
+		}).
+		Run()
+	return results
+}
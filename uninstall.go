@@ -0,0 +1,41 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/jdu211171/clearlinux-cli/pkg/vermgr"
+)
+
+var uninstallCmd = &cobra.Command{
+	Use:   "uninstall <lang>@<version>",
+	Short: "Remove an installed toolchain version",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runUninstall,
+}
+
+type uninstallResult struct {
+	Language string `json:"language"`
+	Version  string `json:"version"`
+}
+
+func runUninstall(cmd *cobra.Command, args []string) error {
+	lang, version, err := parseLangAtVersion(args[0])
+	if err != nil {
+		return err
+	}
+
+	manager, ok := vermgr.Get(lang)
+	if !ok {
+		return fmt.Errorf("unsupported language: %s", lang)
+	}
+
+	if err := manager.Uninstall(version); err != nil {
+		return fmt.Errorf("uninstall %s %s: %w", lang, version, err)
+	}
+
+	return printResult(uninstallResult{Language: lang, Version: version}, func() {
+		fmt.Printf("Uninstalled %s %s\n", highlight.Render(lang), highlight.Render(version))
+	})
+}
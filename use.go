@@ -0,0 +1,41 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/jdu211171/clearlinux-cli/pkg/vermgr"
+)
+
+var useCmd = &cobra.Command{
+	Use:   "use <lang>@<version>",
+	Short: "Switch the active version of an installed toolchain",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runUse,
+}
+
+type useResult struct {
+	Language string `json:"language"`
+	Version  string `json:"version"`
+}
+
+func runUse(cmd *cobra.Command, args []string) error {
+	lang, version, err := parseLangAtVersion(args[0])
+	if err != nil {
+		return err
+	}
+
+	manager, ok := vermgr.Get(lang)
+	if !ok {
+		return fmt.Errorf("unsupported language: %s", lang)
+	}
+
+	if err := manager.Use(version); err != nil {
+		return fmt.Errorf("switch to %s %s: %w", lang, version, err)
+	}
+
+	return printResult(useResult{Language: lang, Version: version}, func() {
+		fmt.Printf("Now using %s %s\n", highlight.Render(lang), highlight.Render(version))
+	})
+}